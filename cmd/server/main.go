@@ -7,14 +7,18 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	_ "translation/docs"
 	appTranslation "translation/internal/application/translation"
 	"translation/internal/config"
 	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/infrastructure/memory"
+	"translation/internal/infrastructure/oidc"
 	"translation/internal/infrastructure/openai"
 	"translation/internal/infrastructure/rabbitmq"
 	redisRepo "translation/internal/infrastructure/redis"
+	"translation/internal/infrastructure/webhook"
 	"translation/internal/interfaces/http"
 
 	"github.com/gofiber/fiber/v2"
@@ -40,18 +44,28 @@ import (
 // @securityDefinitions.apikey ApiKeyAuth
 // @in header
 // @name Authorization
-// @description API Key for authentication. Use format: Bearer YOUR_API_KEY
+// @description API key or user token for authentication. Use format: Bearer YOUR_API_KEY or Bearer YOUR_TOKEN
 
 func main() {
 	// Log application info
 	log.Println("Translation Service - by Kyrylo Kovalenko (git@kovalenko.tech)")
 	log.Println("Website: https://kovalenko.tech")
 
+	// Run mode is the first argument: serve-api, worker or all (default)
+	mode := config.ModeAll
+	if len(os.Args) > 1 {
+		mode = os.Args[1]
+	}
+
 	// Load configuration
-	cfg, err := config.Load()
+	cfg, err := config.Load(mode)
 	if err != nil {
 		log.Fatalf("Failed to load config: %v", err)
 	}
+	log.Printf("Running in %s mode", mode)
+
+	runsAPI := mode == config.ModeAPI || mode == config.ModeAll
+	runsWorker := mode == config.ModeWorker || mode == config.ModeAll
 
 	// Initialize Redis client
 	redisClient := redis.NewClient(&redis.Options{
@@ -66,27 +80,151 @@ func main() {
 	}
 	defer redisClient.Close()
 
-	// Initialize RabbitMQ service
-	rabbitService, err := rabbitmq.NewService(cfg.RabbitMQ.URL, cfg.RabbitMQ.QueueName)
+	// Initialize task queue
+	taskQueue, err := newTaskQueue(cfg, redisClient)
 	if err != nil {
-		log.Fatalf("Failed to connect to RabbitMQ: %v", err)
+		log.Fatalf("Failed to initialize task queue: %v", err)
 	}
-	defer rabbitService.Close()
+	defer taskQueue.Close()
 
-	// Initialize OpenAI service
-	openaiService := openai.NewService(cfg.OpenAI.APIKey)
+	// Initialize OpenAI service, only workers and synchronous translation translate
+	var translator appTranslation.Translator
+	if runsWorker || cfg.OpenAI.APIKey != "" {
+		translator = openai.NewService(cfg.OpenAI.APIKey)
+	}
 
 	// Initialize repository
 	repo := redisRepo.NewRepository(redisClient)
 
+	// Initialize request event bus
+	eventBus := redisRepo.NewEventBus(redisClient)
+
+	// Initialize webhook sender
+	webhookSender := webhook.NewSender(cfg.Webhook.Timeout, cfg.Webhook.AllowedNetworks)
+
+	// Initialize locks shared by instances, an in-process queue has a single instance
+	var locker appTranslation.Locker = redisRepo.NewLocker(redisClient)
+	if cfg.Queue.Backend == config.QueueBackendMemory {
+		locker = memory.NewLocker()
+	}
+
+	// Initialize usage counters of rate limits and quotas, shared by instances
+	usageCounter := redisRepo.NewUsageCounter(redisClient)
+
 	// Initialize domain service
 	domainService := domainTranslation.NewService(repo)
 
 	// Initialize application service
-	appService := appTranslation.NewService(domainService, openaiService, rabbitService)
+	appService := appTranslation.NewService(domainService, translator, taskQueue, eventBus, webhookSender, locker, usageCounter, cfg.Webhook, cfg.Worker, cfg.Retention, cfg.RateLimit)
+
+	// Stop on SIGINT or SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	var consumerDone <-chan struct{}
+	if runsWorker {
+		consumerDone = startWorker(ctx, appService)
+	} else if translator != nil {
+		// Without consumer, synchronous translations of cancelled requests are stopped by a watcher of their own
+		if err := appService.StartCancellationWatcher(ctx); err != nil {
+			log.Printf("Failed to start cancellation watcher: %v", err)
+		}
+	}
+
+	var app *fiber.App
+	if runsAPI {
+		users, err := newUserAuthenticator(cfg)
+		if err != nil {
+			log.Fatalf("Failed to initialize user authentication: %v", err)
+		}
+
+		app = newApp(cfg, appService, users)
+		if cfg.Server.APIKey == "" {
+			log.Println("API_KEY is not set, only managed API keys are accepted")
+		}
+
+		go func() {
+			addr := fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port)
+			log.Printf("Starting server on %s", addr)
+
+			if err := app.Listen(addr); err != nil {
+				log.Printf("Failed to start server: %v", err)
+				stop()
+			}
+		}()
+	}
+
+	<-ctx.Done()
+	log.Println("Shutting down...")
+
+	// HTTP requests and tasks in progress share one shutdown timeout
+	deadline := time.Now().Add(cfg.Server.ShutdownTimeout)
+
+	if app != nil {
+		if err := app.ShutdownWithTimeout(cfg.Server.ShutdownTimeout); err != nil {
+			log.Printf("Error shutting down server: %v", err)
+		}
+	}
+
+	if consumerDone != nil {
+		log.Println("Waiting for tasks in progress to finish...")
+		select {
+		case <-consumerDone:
+			log.Println("Tasks in progress finished")
+		case <-time.After(time.Until(deadline)):
+			// Unfinished tasks are not acknowledged, so the queue hands them to another worker
+			log.Println("Shutdown timeout exceeded, unfinished tasks will be processed again")
+		}
+	}
+}
+
+// startWorker recovers incomplete requests and starts task consumer, stale request recovery, chunk scheduler,
+// webhook dispatcher and request archiver until ctx is done. Returned channel is closed once consumer
+// stopped and tasks in progress finished.
+func startWorker(ctx context.Context, appService *appTranslation.Service) <-chan struct{} {
+	// Recover incomplete requests on startup
+	log.Println("Recovering incomplete translation requests...")
+	if err := appService.RecoverIncompleteRequests(ctx); err != nil {
+		log.Printf("Failed to recover incomplete requests: %v", err)
+	}
+
+	// Start consumer in a separate goroutine
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := appService.StartConsumer(ctx); err != nil {
+			log.Printf("Failed to start consumer: %v", err)
+		}
+	}()
 
+	// Start recovery of requests whose worker died
+	if err := appService.StartStaleRequestRecovery(ctx); err != nil {
+		log.Printf("Failed to start stale request recovery: %v", err)
+	}
+
+	// Start chunk scheduler
+	if err := appService.StartChunkScheduler(ctx); err != nil {
+		log.Printf("Failed to start chunk scheduler: %v", err)
+	}
+
+	// Start webhook dispatcher
+	if err := appService.StartWebhookDispatcher(ctx); err != nil {
+		log.Printf("Failed to start webhook dispatcher: %v", err)
+	}
+
+	// Start archiving of finished requests
+	if err := appService.StartRequestArchiver(ctx); err != nil {
+		log.Printf("Failed to start request archiver: %v", err)
+	}
+
+	return done
+}
+
+// newApp creates Fiber application serving HTTP API
+func newApp(cfg *config.Config, appService *appTranslation.Service, users http.UserAuthenticator) *fiber.App {
 	// Initialize HTTP handlers
-	handler := http.NewHandler(appService)
+	handler := http.NewHandler(appService, cfg.Sync)
+	otaHandler := http.NewOTAHandler(appService, cfg.OTA)
 
 	// Create Fiber application
 	app := fiber.New(fiber.Config{
@@ -101,47 +239,60 @@ func main() {
 	// Add middleware
 	app.Use(logger.New())
 	app.Use(cors.New(cors.Config{
-		AllowOrigins: "*",
-		AllowHeaders: "Origin, Content-Type, Accept",
+		AllowOrigins:  "*",
+		AllowHeaders:  "Origin, Content-Type, Accept, If-None-Match, Idempotency-Key",
+		ExposeHeaders: "ETag, Idempotent-Replayed, RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset, Retry-After",
 	}))
 
 	// Setup routes
-	http.SetupRoutes(app, handler, cfg.Server.APIKey)
+	http.SetupRoutes(app, handler, otaHandler, users, cfg.Server.APIKey)
 
-	// Recover incomplete requests on startup
-	log.Println("Recovering incomplete translation requests...")
-	if err := appService.RecoverIncompleteRequests(context.Background()); err != nil {
-		log.Printf("Failed to recover incomplete requests: %v", err)
-	}
+	return app
+}
 
-	// Start consumer in a separate goroutine
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+// newUserAuthenticator creates verifier of user tokens, nil when they aren't accepted
+func newUserAuthenticator(cfg *config.Config) (http.UserAuthenticator, error) {
+	if !cfg.JWT.Enabled() {
+		return nil, nil
+	}
 
-	go func() {
-		if err := appService.StartConsumer(ctx); err != nil {
-			log.Printf("Failed to start consumer: %v", err)
-		}
-	}()
+	verifier, err := oidc.NewVerifier(cfg.JWT.JWKSURL, cfg.JWT.JWKSFile, oidc.Options{
+		Issuer:          cfg.JWT.Issuer,
+		Audience:        cfg.JWT.Audience,
+		RolesClaim:      cfg.JWT.RolesClaim,
+		ProjectsClaim:   cfg.JWT.ProjectsClaim,
+		RefreshInterval: cfg.JWT.RefreshInterval,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	// Configure graceful shutdown
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	log.Printf("Accepting user tokens signed with keys from JWKS")
+	return verifier, nil
+}
 
-	go func() {
-		<-quit
-		log.Println("Shutting down server...")
-		cancel() // Stop consumer
-		if err := app.Shutdown(); err != nil {
-			log.Printf("Error shutting down server: %v", err)
-		}
-	}()
+// taskQueue represents task queue backend that holds connections until closed
+type taskQueue interface {
+	appTranslation.TaskQueue
+	Close() error
+}
 
-	// Start server
-	addr := fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port)
-	log.Printf("Starting server on %s", addr)
+// newTaskQueue creates task queue of configured backend
+func newTaskQueue(cfg *config.Config, redisClient *redis.Client) (taskQueue, error) {
+	retry := domainTranslation.RetryPolicy{
+		MaxAttempts: cfg.Queue.MaxAttempts,
+		Delay:       cfg.Queue.RetryDelay,
+	}
 
-	if err := app.Listen(addr); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	switch cfg.Queue.Backend {
+	case config.QueueBackendRedis:
+		log.Printf("Using Redis Streams task queue %q", cfg.Queue.Stream)
+		return redisRepo.NewQueue(redisClient, cfg.Queue.Stream, retry, cfg.Queue.ClaimIdle), nil
+	case config.QueueBackendMemory:
+		log.Printf("Using in-process task queue, queued tasks are lost on restart")
+		return memory.NewQueue(cfg.Queue.Capacity, retry), nil
+	default:
+		log.Printf("Using RabbitMQ task queue %q", cfg.RabbitMQ.QueueName)
+		return rabbitmq.NewService(cfg.RabbitMQ.URL, cfg.RabbitMQ.QueueName, cfg.RabbitMQ.Prefetch, retry)
 	}
 }
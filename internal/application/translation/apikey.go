@@ -0,0 +1,57 @@
+package translation
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"translation/internal/domain/translation"
+)
+
+// CreateAPIKey creates API key within access of issuer and returns it with its secret
+func (s *Service) CreateAPIKey(ctx context.Context, issuer *translation.Principal, options translation.CreateAPIKeyOptions) (*translation.APIKey, string, error) {
+	key, secret, err := s.domainService.CreateAPIKey(ctx, issuer, options)
+	if err != nil {
+		return nil, "", err
+	}
+
+	log.Printf("Created API key %s (%s)", key.ID, key.Name)
+	return key, secret, nil
+}
+
+// AuthenticateAPIKey returns active API key with given secret
+func (s *Service) AuthenticateAPIKey(ctx context.Context, secret string) (*translation.APIKey, error) {
+	return s.domainService.AuthenticateAPIKey(ctx, secret)
+}
+
+// GetAPIKey gets API key by ID
+func (s *Service) GetAPIKey(ctx context.Context, id string) (*translation.APIKey, error) {
+	return s.domainService.GetAPIKey(ctx, id)
+}
+
+// GetAPIKeys gets API keys ordered by creation time
+func (s *Service) GetAPIKeys(ctx context.Context) ([]*translation.APIKey, error) {
+	return s.domainService.GetAPIKeys(ctx)
+}
+
+// RevokeAPIKey revokes API key within access of issuer
+func (s *Service) RevokeAPIKey(ctx context.Context, issuer *translation.Principal, id string) (*translation.APIKey, error) {
+	key, err := s.domainService.RevokeAPIKey(ctx, issuer, id)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Revoked API key %s (%s)", key.ID, key.Name)
+	return key, nil
+}
+
+// RotateAPIKey replaces API key within access of issuer with a new one, the old key stays valid for overlap
+func (s *Service) RotateAPIKey(ctx context.Context, issuer *translation.Principal, id string, overlap time.Duration) (*translation.APIKey, string, error) {
+	key, secret, err := s.domainService.RotateAPIKey(ctx, issuer, id, overlap)
+	if err != nil {
+		return nil, "", err
+	}
+
+	log.Printf("Rotated API key %s to %s, the old key expires in %s", id, key.ID, overlap)
+	return key, secret, nil
+}
@@ -0,0 +1,88 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"translation/internal/domain/translation"
+)
+
+const (
+	// archiveInterval is how often finished requests are archived
+	archiveInterval = 10 * time.Minute
+
+	// archiveLockTTL bounds one archiving run, so a crashed instance doesn't block archiving
+	archiveLockTTL = 10 * time.Minute
+)
+
+// ListTranslationRequests lists stored and archived requests matching filter
+func (s *Service) ListTranslationRequests(ctx context.Context, filter translation.RequestFilter) (*translation.RequestPage, error) {
+	return s.domainService.ListTranslationRequests(ctx, filter)
+}
+
+// StartRequestArchiver starts archiving finished requests periodically until ctx is done,
+// unless retention keeps requests
+func (s *Service) StartRequestArchiver(ctx context.Context) error {
+	if s.retentionConfig.ArchiveAfter <= 0 {
+		log.Printf("Request archiving is disabled, finished requests are kept")
+		return nil
+	}
+
+	go func() {
+		ticker := time.NewTicker(archiveInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := s.ArchiveRequests(ctx); err != nil {
+					log.Printf("Failed to archive requests: %v", err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// ArchiveRequests replaces requests finished longer than retention allows with their summaries and deletes
+// summaries past their retention. One instance archives at a time. Returns number of archived requests.
+func (s *Service) ArchiveRequests(ctx context.Context) (int, error) {
+	acquired, err := s.locker.AcquireLock(ctx, "archive", archiveLockTTL)
+	if err != nil {
+		return 0, fmt.Errorf("failed to acquire archive lock: %w", err)
+	}
+	if !acquired {
+		return 0, nil
+	}
+	defer func() {
+		if err := s.locker.ReleaseLock(ctx, "archive"); err != nil {
+			log.Printf("Failed to release archive lock: %v", err)
+		}
+	}()
+
+	now := time.Now()
+	archived, err := s.domainService.ArchiveRequests(ctx, now.Add(-s.retentionConfig.ArchiveAfter))
+	if archived > 0 {
+		log.Printf("Archived %d finished requests", archived)
+	}
+	if err != nil {
+		return archived, err
+	}
+
+	if s.retentionConfig.ArchiveRetention > 0 {
+		deleted, err := s.domainService.PruneArchivedRequests(ctx, now.Add(-s.retentionConfig.ArchiveRetention))
+		if err != nil {
+			return archived, err
+		}
+		if deleted > 0 {
+			log.Printf("Deleted %d summaries of archived requests", deleted)
+		}
+	}
+
+	return archived, nil
+}
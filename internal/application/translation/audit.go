@@ -0,0 +1,22 @@
+package translation
+
+import (
+	"context"
+
+	"translation/internal/domain/translation"
+)
+
+// RecordAuditEntry appends entry to audit log
+func (s *Service) RecordAuditEntry(ctx context.Context, entry *translation.AuditEntry) error {
+	return s.domainService.RecordAuditEntry(ctx, entry)
+}
+
+// ListAuditEntries lists audit entries matching filter, newest first
+func (s *Service) ListAuditEntries(ctx context.Context, filter translation.AuditFilter) (*translation.AuditPage, error) {
+	return s.domainService.ListAuditEntries(ctx, filter)
+}
+
+// ExportAuditEntries calls fn with all audit entries matching filter, oldest first, a batch at a time
+func (s *Service) ExportAuditEntries(ctx context.Context, filter translation.AuditFilter, fn func([]*translation.AuditEntry) error) error {
+	return s.domainService.ExportAuditEntries(ctx, filter, fn)
+}
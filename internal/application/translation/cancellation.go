@@ -0,0 +1,120 @@
+package translation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+)
+
+// errRequestCancelled is cause of task contexts stopped because their request was cancelled
+var errRequestCancelled = errors.New("translation request cancelled")
+
+// runningTasks tracks contexts of tasks in progress by request, so a cancellation broadcast
+// stops them without workers polling request status
+type runningTasks struct {
+	mu      sync.Mutex
+	next    int
+	cancels map[uuid.UUID]map[int]context.CancelCauseFunc
+}
+
+// newRunningTasks creates empty registry of tasks in progress
+func newRunningTasks() *runningTasks {
+	return &runningTasks{
+		cancels: make(map[uuid.UUID]map[int]context.CancelCauseFunc),
+	}
+}
+
+// track returns context of task of request that is cancelled when request is, and func that must be
+// called once the task is done
+func (r *runningTasks) track(ctx context.Context, requestID uuid.UUID) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	r.mu.Lock()
+	r.next++
+	id := r.next
+	if r.cancels[requestID] == nil {
+		r.cancels[requestID] = make(map[int]context.CancelCauseFunc)
+	}
+	r.cancels[requestID][id] = cancel
+	r.mu.Unlock()
+
+	return ctx, func() {
+		r.mu.Lock()
+		delete(r.cancels[requestID], id)
+		if len(r.cancels[requestID]) == 0 {
+			delete(r.cancels, requestID)
+		}
+		r.mu.Unlock()
+
+		cancel(nil)
+	}
+}
+
+// cancel stops tasks of request in progress, returns number of stopped tasks
+func (r *runningTasks) cancel(requestID uuid.UUID) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, cancel := range r.cancels[requestID] {
+		cancel(errRequestCancelled)
+	}
+	return len(r.cancels[requestID])
+}
+
+// isCancelled reports whether task context was stopped because its request was cancelled
+func isCancelled(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), errRequestCancelled)
+}
+
+// watchCancellations stops tasks of cancelled requests this process runs until ctx is done
+func (s *Service) watchCancellations(ctx context.Context) error {
+	cancellations, err := s.eventBus.SubscribeCancellations(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to cancellations: %w", err)
+	}
+
+	go func() {
+		for requestID := range cancellations {
+			if stopped := s.running.cancel(requestID); stopped > 0 {
+				log.Printf("Request ID %s was cancelled, stopping %d tasks in progress", requestID, stopped)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// StartCancellationWatcher stops synchronous translations of cancelled requests until ctx is done. Consumer
+// watches cancellations itself, so only processes serving the API without one need to start it.
+func (s *Service) StartCancellationWatcher(ctx context.Context) error {
+	return s.watchCancellations(ctx)
+}
+
+// CancelTranslationRequest cancels a translation request and stops workers translating it. With discard
+// translations the request already saved are removed, otherwise they are kept. Returns number of
+// discarded translations.
+func (s *Service) CancelTranslationRequest(ctx context.Context, requestID uuid.UUID, discard bool) (int, error) {
+	if err := s.domainService.CancelTranslationRequest(ctx, requestID); err != nil {
+		return 0, err
+	}
+
+	s.notifyStatus(ctx, requestID, translation.StatusCancelled)
+
+	// Chunks not started yet see the status, the ones in progress are stopped by the broadcast
+	if err := s.eventBus.PublishCancellation(ctx, requestID); err != nil {
+		log.Printf("Failed to broadcast cancellation of request ID %s: %v", requestID, err)
+	}
+
+	if !discard {
+		return 0, nil
+	}
+
+	ctx = translation.WithChangeRequestID(ctx, requestID)
+	return s.domainService.DiscardRequestTranslations(ctx, requestID)
+}
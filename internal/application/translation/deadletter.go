@@ -0,0 +1,83 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+)
+
+// failDeadLetteredRequest marks request of task that failed all attempts as failed with the last error
+func (s *Service) failDeadLetteredRequest(ctx context.Context, task *translation.TranslationTask, cause error) {
+	log.Printf("Request ID %s failed after all attempts: %v", task.RequestID, cause)
+	s.failRequest(ctx, task.RequestID, cause.Error())
+
+	if task.IsChunk() {
+		s.finishChunk(ctx, task)
+	}
+}
+
+// failRequest marks request as failed with reason unless it is already finished
+func (s *Service) failRequest(ctx context.Context, requestID uuid.UUID, reason string) {
+	if err := s.domainService.FailTranslationRequest(ctx, requestID, reason); err != nil {
+		log.Printf("Failed to mark request ID %s as failed: %v", requestID, err)
+		return
+	}
+
+	s.notifyStatus(ctx, requestID, translation.StatusFailed)
+}
+
+// GetDeadLetters gets up to limit tasks that failed all attempts
+func (s *Service) GetDeadLetters(ctx context.Context, limit int) ([]*translation.DeadLetter, error) {
+	return s.taskQueue.GetDeadLetters(ctx, limit)
+}
+
+// RequeueDeadLetters processes requests of dead-lettered tasks with given IDs again, all of them when ids is empty.
+// Failed requests are reopened and queued from the start; tasks of requests that are no longer failed are dropped.
+// Returns number of tasks removed from the dead-letter queue.
+func (s *Service) RequeueDeadLetters(ctx context.Context, ids []string) (int, error) {
+	// Several chunks of one request may be dead-lettered, the request is queued once
+	requeued := make(map[uuid.UUID]bool)
+
+	return s.taskQueue.ProcessDeadLetters(ctx, ids, func(deadLetter *translation.DeadLetter) error {
+		if deadLetter.Task == nil {
+			// Malformed task can't be processed, drop it
+			return nil
+		}
+
+		requestID := deadLetter.Task.RequestID
+		if requeued[requestID] {
+			return nil
+		}
+
+		request, err := s.domainService.ReopenTranslationRequest(ctx, requestID)
+		if err != nil {
+			log.Printf("Dropping dead-lettered task of request ID %s: %v", requestID, err)
+			return nil
+		}
+
+		if err := s.taskQueue.PublishTask(ctx, requestTask(request)); err != nil {
+			// Keep the task dead-lettered and the request failed, so requeue can be repeated
+			s.domainService.FailTranslationRequest(ctx, request.ID, deadLetter.Error)
+			return fmt.Errorf("failed to publish task to queue: %w", err)
+		}
+
+		requeued[requestID] = true
+		s.notifyStatus(ctx, request.ID, translation.StatusPending)
+		log.Printf("Requeued dead-lettered request ID: %s", request.ID)
+		return nil
+	})
+}
+
+// PurgeDeadLetters removes all dead-lettered tasks
+func (s *Service) PurgeDeadLetters(ctx context.Context) (int, error) {
+	return s.taskQueue.PurgeDeadLetters(ctx)
+}
+
+// GetQueueInfo returns number of tasks in task queue
+func (s *Service) GetQueueInfo() (int, error) {
+	return s.taskQueue.GetQueueInfo()
+}
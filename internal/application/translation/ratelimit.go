@@ -0,0 +1,140 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"translation/internal/domain/translation"
+)
+
+// UsageCounter defines interface of usage counters shared by service instances
+type UsageCounter interface {
+	// Add increments to their counters unless that makes any exceed its limit, in which case nothing is added.
+	// Returns counter values, including increments when they were added, and whether they were.
+	AddUsage(ctx context.Context, increments []translation.UsageIncrement) ([]int64, bool, error)
+
+	// Get counter values, 0 for counters that don't exist
+	GetUsage(ctx context.Context, keys []string) ([]int64, error)
+}
+
+// usageKey returns key of counter of quota for subject in window starting at start
+func usageKey(name translation.QuotaName, subject string, start time.Time) string {
+	return fmt.Sprintf("usage:%s:%s:%d", name, subject, start.Unix())
+}
+
+// quota represents limit of quota for subject: API key or user for requests, project for the rest
+type quota struct {
+	name    translation.QuotaName
+	subject string
+	limit   int64
+}
+
+// projectQuotas returns quotas of project
+func (s *Service) projectQuotas(project string) []quota {
+	return []quota{
+		{translation.QuotaKeysPerDay, project, int64(s.rateLimitConfig.ProjectQuota(s.rateLimitConfig.KeysPerDay, project))},
+		{translation.QuotaCharactersPerMonth, project, int64(s.rateLimitConfig.ProjectQuota(s.rateLimitConfig.CharactersPerMonth, project))},
+	}
+}
+
+// requestQuota returns rate limit of API requests of principal
+func (s *Service) requestQuota(principalID string) quota {
+	return quota{translation.QuotaRequestsPerMinute, principalID, int64(s.rateLimitConfig.RequestsPerMinute)}
+}
+
+// addUsage adds amounts to quotas unless that exceeds one of them. Returns usage of quotas, and the quota
+// that would be exceeded, nil when amounts were added.
+func (s *Service) addUsage(ctx context.Context, quotas []quota, amounts []int64) ([]*translation.Usage, *translation.Usage, error) {
+	now := time.Now()
+	increments := make([]translation.UsageIncrement, len(quotas))
+	usages := make([]*translation.Usage, len(quotas))
+	for i, q := range quotas {
+		start, end := q.name.Window(now)
+		increments[i] = translation.UsageIncrement{Key: usageKey(q.name, q.subject, start), Amount: amounts[i], Limit: q.limit, ExpiresAt: end}
+		usages[i] = &translation.Usage{Name: q.name, Limit: q.limit, ResetAt: end}
+	}
+
+	values, added, err := s.usageCounter.AddUsage(ctx, increments)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to add usage: %w", err)
+	}
+
+	var exceeded *translation.Usage
+	for i, usage := range usages {
+		usage.Used = values[i]
+		if !added && exceeded == nil && usage.Limit > 0 && usage.Used+amounts[i] > usage.Limit {
+			exceeded = usage
+		}
+	}
+
+	return usages, exceeded, nil
+}
+
+// AllowRequest counts API request of principal against its rate limit. Returns usage of the rate limit,
+// nil when it is unlimited, and whether request is allowed.
+func (s *Service) AllowRequest(ctx context.Context, principalID string) (*translation.Usage, bool, error) {
+	q := s.requestQuota(principalID)
+	if q.limit == 0 {
+		return nil, true, nil
+	}
+
+	usages, exceeded, err := s.addUsage(ctx, []quota{q}, []int64{1})
+	if err != nil {
+		return nil, false, err
+	}
+	return usages[0], exceeded == nil, nil
+}
+
+// ConsumeQuotas charges number of translations (keys times languages) and characters to quotas of project
+// unless that exceeds one of them. Returns the quota that would be exceeded, nil when request is within
+// quotas. Unlimited quotas are charged too, so their usage can be seen.
+func (s *Service) ConsumeQuotas(ctx context.Context, project string, keys int64, characters int64) (*translation.Usage, error) {
+	_, exceeded, err := s.addUsage(ctx, s.projectQuotas(project), []int64{keys, characters})
+	if exceeded != nil {
+		log.Printf("Refused request of project %q exceeding %s quota of %d", project, exceeded.Name, exceeded.Limit)
+	}
+	return exceeded, err
+}
+
+// RefundQuotas takes back translations and characters charged to quotas of project for request that wasn't
+// created or retried
+func (s *Service) RefundQuotas(ctx context.Context, project string, keys int64, characters int64) {
+	quotas := s.projectQuotas(project)
+	for i := range quotas {
+		quotas[i].limit = 0
+	}
+
+	if _, _, err := s.addUsage(ctx, quotas, []int64{-keys, -characters}); err != nil {
+		log.Printf("Failed to refund quotas of project %q: %v", project, err)
+	}
+}
+
+// GetUsage gets usage of rate limit of principal, unless it is unlimited, and quotas of project in their
+// current windows
+func (s *Service) GetUsage(ctx context.Context, principalID string, project string) ([]*translation.Usage, error) {
+	quotas := s.projectQuotas(project)
+	if q := s.requestQuota(principalID); q.limit > 0 {
+		quotas = append([]quota{q}, quotas...)
+	}
+
+	now := time.Now()
+	keys := make([]string, len(quotas))
+	usages := make([]*translation.Usage, len(quotas))
+	for i, q := range quotas {
+		start, end := q.name.Window(now)
+		keys[i] = usageKey(q.name, q.subject, start)
+		usages[i] = &translation.Usage{Name: q.name, Limit: q.limit, ResetAt: end}
+	}
+
+	values, err := s.usageCounter.GetUsage(ctx, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usage: %w", err)
+	}
+	for i, usage := range usages {
+		usage.Used = values[i]
+	}
+
+	return usages, nil
+}
@@ -0,0 +1,172 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+)
+
+// Locker defines interface of locks shared by service instances
+type Locker interface {
+	// Acquire lock until ttl passes or it is released, reports whether it was free
+	AcquireLock(ctx context.Context, name string, ttl time.Duration) (bool, error)
+
+	// Release lock acquired by this instance
+	ReleaseLock(ctx context.Context, name string) error
+}
+
+const (
+	// recoveryLockTTL bounds one recovery of incomplete requests, so a crashed instance doesn't block it
+	recoveryLockTTL = 10 * time.Minute
+
+	// staleRequestCheckInterval is how often requests whose worker died are looked for
+	staleRequestCheckInterval = time.Minute
+
+	// staleRequestLockTTL bounds one check for stale requests, so a crashed instance doesn't block them
+	staleRequestLockTTL = 5 * time.Minute
+)
+
+// RecoverIncompleteRequests queues incomplete requests again on server startup. One instance recovers
+// at a time. Pending requests are only recovered when the queue lost their task, and processing requests
+// when they are stale, the rest are still being worked on. Recovered requests resume from their checkpoints.
+func (s *Service) RecoverIncompleteRequests(ctx context.Context) error {
+	acquired, err := s.locker.AcquireLock(ctx, "recovery", recoveryLockTTL)
+	if err != nil {
+		return fmt.Errorf("failed to acquire recovery lock: %w", err)
+	}
+	if !acquired {
+		log.Printf("Incomplete requests are being recovered by another instance, skipping recovery")
+		return nil
+	}
+	defer func() {
+		if err := s.locker.ReleaseLock(ctx, "recovery"); err != nil {
+			log.Printf("Failed to release recovery lock: %v", err)
+		}
+	}()
+
+	log.Printf("Starting recovery of incomplete translation requests...")
+
+	incompleteRequests, err := s.GetIncompleteRequests(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get incomplete requests: %w", err)
+	}
+
+	if len(incompleteRequests) == 0 {
+		log.Printf("No incomplete requests found")
+		return nil
+	}
+
+	log.Printf("Found %d incomplete requests to recover", len(incompleteRequests))
+
+	for _, request := range incompleteRequests {
+		switch request.Status {
+		case translation.StatusPending:
+			queued, err := s.taskQueue.HasRequestTask(ctx, request.ID)
+			if err != nil {
+				log.Printf("Failed to check request ID %s: %v", request.ID, err)
+				continue
+			}
+			if queued {
+				log.Printf("Request ID %s is still queued, skipping", request.ID)
+				continue
+			}
+		case translation.StatusProcessing:
+			stale, err := s.domainService.IsRequestStale(ctx, request)
+			if err != nil {
+				log.Printf("Failed to check request ID %s: %v", request.ID, err)
+				continue
+			}
+			if !stale {
+				log.Printf("Request ID %s is still being processed, skipping", request.ID)
+				continue
+			}
+		}
+
+		log.Printf("Recovering request ID: %s (status: %s)", request.ID, request.Status)
+		s.requeueRequest(ctx, request)
+	}
+
+	log.Printf("Recovery of incomplete requests completed")
+	return nil
+}
+
+// StartStaleRequestRecovery starts queueing requests whose worker died again periodically until ctx is done
+func (s *Service) StartStaleRequestRecovery(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(staleRequestCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := s.RequeueStaleRequests(ctx); err != nil {
+					log.Printf("Failed to requeue stale requests: %v", err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// RequeueStaleRequests queues processing requests that stopped making progress again, so they resume
+// from their checkpoints. One instance checks at a time. Returns number of requeued requests.
+func (s *Service) RequeueStaleRequests(ctx context.Context) (int, error) {
+	acquired, err := s.locker.AcquireLock(ctx, "stale_requests", staleRequestLockTTL)
+	if err != nil {
+		return 0, fmt.Errorf("failed to acquire stale requests lock: %w", err)
+	}
+	if !acquired {
+		return 0, nil
+	}
+	defer func() {
+		if err := s.locker.ReleaseLock(ctx, "stale_requests"); err != nil {
+			log.Printf("Failed to release stale requests lock: %v", err)
+		}
+	}()
+
+	requests, err := s.domainService.GetStaleRequests(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	requeued := 0
+	for _, request := range requests {
+		log.Printf("Request ID %s stopped making progress, queueing it again", request.ID)
+		if s.requeueRequest(ctx, request) {
+			requeued++
+		}
+	}
+
+	return requeued, nil
+}
+
+// requeueRequest publishes task of request again, marking request as failed when it can't be queued.
+// Reports whether request was queued.
+func (s *Service) requeueRequest(ctx context.Context, request *translation.TranslationRequest) bool {
+	if err := s.taskQueue.PublishTask(ctx, requestTask(request)); err != nil {
+		log.Printf("Failed to publish recovery task for request ID %s: %v", request.ID, err)
+		// Mark as failed if we can't queue it
+		request.MarkAsFailed()
+		s.domainService.GetRepository().UpdateRequestStatus(ctx, request.ID, request.Status)
+		s.notifyStatus(ctx, request.ID, request.Status)
+		return false
+	}
+
+	log.Printf("Successfully queued recovery task for request ID: %s", request.ID)
+	return true
+}
+
+// renewLease records progress of request, failing to record doesn't stop processing
+func (s *Service) renewLease(ctx context.Context, requestID uuid.UUID) {
+	if err := s.domainService.RenewRequestLease(ctx, requestID); err != nil {
+		log.Printf("Failed to renew lease of request ID %s: %v", requestID, err)
+	}
+}
@@ -0,0 +1,37 @@
+package translation
+
+import (
+	"context"
+
+	"translation/internal/domain/translation"
+)
+
+// CreateRelease freezes current source values and translations into a named release
+func (s *Service) CreateRelease(ctx context.Context, name string, description string) (*translation.Release, error) {
+	return s.domainService.CreateRelease(ctx, name, description)
+}
+
+// GetRelease gets release by name
+func (s *Service) GetRelease(ctx context.Context, name string) (*translation.Release, error) {
+	return s.domainService.GetRelease(ctx, name)
+}
+
+// GetAllReleases gets all releases ordered by creation time
+func (s *Service) GetAllReleases(ctx context.Context) ([]*translation.Release, error) {
+	return s.domainService.GetAllReleases(ctx)
+}
+
+// DiffReleases compares two releases
+func (s *Service) DiffReleases(ctx context.Context, from string, to string) (*translation.ReleaseDiff, error) {
+	return s.domainService.DiffReleases(ctx, from, to)
+}
+
+// PublishRelease makes release available for over-the-air delivery
+func (s *Service) PublishRelease(ctx context.Context, name string) (*translation.Release, error) {
+	return s.domainService.PublishRelease(ctx, name)
+}
+
+// GetPublishedRelease gets published release by name or "latest"
+func (s *Service) GetPublishedRelease(ctx context.Context, name string) (*translation.Release, error) {
+	return s.domainService.GetPublishedRelease(ctx, name)
+}
@@ -0,0 +1,103 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"translation/internal/domain/translation"
+)
+
+// chunkSchedulerInterval is how often backlogs are checked for chunks whose project got room,
+// e.g. because leases of chunks lost with a crashed worker expired
+const chunkSchedulerInterval = 10 * time.Second
+
+// requestTask creates task processing whole request
+func requestTask(request *translation.TranslationRequest) *translation.TranslationTask {
+	return &translation.TranslationTask{
+		RequestID:  request.ID,
+		SourceData: request.SourceData,
+		Languages:  request.Languages,
+		Project:    request.Project,
+		Priority:   uint8(request.Priority),
+	}
+}
+
+// dispatchChunks queues chunks from project backlog while the project has room for them, so a project
+// with a large backlog never keeps more than its limit of chunks in the queue ahead of other projects
+func (s *Service) dispatchChunks(ctx context.Context, project string) {
+	limit := s.workerConfig.ProjectLimit(project)
+	if limit <= 0 {
+		limit = math.MaxInt32
+	}
+
+	chunks, err := s.domainService.ReleaseChunks(ctx, project, limit)
+	if err != nil {
+		log.Printf("Failed to release chunks of project %q: %v", project, err)
+		return
+	}
+
+	for _, chunk := range chunks {
+		task := &translation.TranslationTask{
+			RequestID: chunk.RequestID,
+			Languages: []string{chunk.Language},
+			Project:   project,
+			Priority:  uint8(chunk.Priority),
+			PlanID:    &chunk.PlanID,
+			Chunk:     chunk.Index,
+			Keys:      chunk.Keys,
+		}
+		if err := s.taskQueue.PublishTask(ctx, task); err != nil {
+			// Chunks that were not queued would never complete the request
+			log.Printf("Failed to publish chunk %d of request ID %s: %v", chunk.Index, chunk.RequestID, err)
+			if err := s.domainService.FinishChunk(ctx, project, chunk.ID()); err != nil {
+				log.Printf("Failed to finish chunk %d of request ID %s: %v", chunk.Index, chunk.RequestID, err)
+			}
+			s.failRequest(ctx, chunk.RequestID, fmt.Sprintf("failed to publish chunk: %v", err))
+		}
+	}
+}
+
+// finishChunk frees place of processed chunk task in its project and queues the next chunk
+func (s *Service) finishChunk(ctx context.Context, task *translation.TranslationTask) {
+	chunkID := translation.ChunkID(task.RequestID, *task.PlanID, task.Chunk)
+	if err := s.domainService.FinishChunk(ctx, task.Project, chunkID); err != nil {
+		log.Printf("Failed to finish chunk %d of request ID %s: %v", task.Chunk, task.RequestID, err)
+	}
+
+	s.dispatchChunks(ctx, task.Project)
+}
+
+// StartChunkScheduler starts queueing chunks of all project backlogs periodically until ctx is done
+func (s *Service) StartChunkScheduler(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(chunkSchedulerInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.dispatchScheduledChunks(ctx)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// dispatchScheduledChunks queues chunks of every project with backlog
+func (s *Service) dispatchScheduledChunks(ctx context.Context) {
+	projects, err := s.domainService.GetScheduledProjects(ctx)
+	if err != nil {
+		log.Printf("Failed to get scheduled projects: %v", err)
+		return
+	}
+
+	for _, project := range projects {
+		s.dispatchChunks(ctx, project)
+	}
+}
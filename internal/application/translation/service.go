@@ -5,58 +5,139 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"translation/internal/config"
 	"translation/internal/domain/translation"
 	"translation/internal/infrastructure/openai"
-	"translation/internal/infrastructure/rabbitmq"
 
 	"github.com/google/uuid"
 )
 
+// Translator defines interface of the translation provider
+type Translator interface {
+	// Translate text from one language to another
+	Translate(ctx context.Context, req *openai.TranslationRequest) (*openai.TranslationResponse, error)
+}
+
+// TaskQueue defines interface of the translation task queue
+type TaskQueue interface {
+	// Publish task to queue
+	PublishTask(ctx context.Context, task *translation.TranslationTask) error
+
+	// Consume tasks from queue with given number of workers until ctx is done and tasks in progress
+	// are finished, deadLetter is called for tasks that failed all attempts
+	ConsumeTasks(ctx context.Context, workers int, handler func(*translation.TranslationTask) error, deadLetter func(*translation.TranslationTask, error)) error
+
+	// Get up to limit dead-lettered tasks
+	GetDeadLetters(ctx context.Context, limit int) ([]*translation.DeadLetter, error)
+
+	// Call handler for dead-lettered tasks with given IDs, all of them when ids is empty,
+	// removing tasks handler succeeded for
+	ProcessDeadLetters(ctx context.Context, ids []string, handler func(*translation.DeadLetter) error) (int, error)
+
+	// Remove all dead-lettered tasks
+	PurgeDeadLetters(ctx context.Context) (int, error)
+
+	// Get number of tasks in queue
+	GetQueueInfo() (int, error)
+
+	// Report whether a task of request is waiting in queue, waiting for retry or being processed
+	HasRequestTask(ctx context.Context, requestID uuid.UUID) (bool, error)
+}
+
+// EventBus defines interface of the request progress event bus
+type EventBus interface {
+	// Publish event to subscribers of its request
+	PublishEvent(ctx context.Context, event *translation.RequestEvent) error
+
+	// Subscribe to events of request until ctx is done or returned cancel is called
+	SubscribeEvents(ctx context.Context, requestID uuid.UUID) (<-chan *translation.RequestEvent, func(), error)
+
+	// Broadcast cancellation of request to all workers
+	PublishCancellation(ctx context.Context, requestID uuid.UUID) error
+
+	// Subscribe to cancelled request IDs until ctx is done
+	SubscribeCancellations(ctx context.Context) (<-chan uuid.UUID, error)
+}
+
 // Service represents application service for working with translations
 type Service struct {
-	domainService *translation.Service
-	openaiService *openai.Service
-	rabbitService *rabbitmq.Service
+	domainService   *translation.Service
+	openaiService   Translator
+	taskQueue       TaskQueue
+	eventBus        EventBus
+	webhookSender   WebhookSender
+	locker          Locker
+	usageCounter    UsageCounter
+	webhookConfig   config.WebhookConfig
+	workerConfig    config.WorkerConfig
+	retentionConfig config.RetentionConfig
+	rateLimitConfig config.RateLimitConfig
+	running         *runningTasks
 }
 
 // NewService creates a new application service instance
 func NewService(
 	domainService *translation.Service,
-	openaiService *openai.Service,
-	rabbitService *rabbitmq.Service,
+	openaiService Translator,
+	taskQueue TaskQueue,
+	eventBus EventBus,
+	webhookSender WebhookSender,
+	locker Locker,
+	usageCounter UsageCounter,
+	webhookConfig config.WebhookConfig,
+	workerConfig config.WorkerConfig,
+	retentionConfig config.RetentionConfig,
+	rateLimitConfig config.RateLimitConfig,
 ) *Service {
 	return &Service{
-		domainService: domainService,
-		openaiService: openaiService,
-		rabbitService: rabbitService,
+		domainService:   domainService,
+		openaiService:   openaiService,
+		taskQueue:       taskQueue,
+		eventBus:        eventBus,
+		webhookSender:   webhookSender,
+		locker:          locker,
+		usageCounter:    usageCounter,
+		webhookConfig:   webhookConfig,
+		workerConfig:    workerConfig,
+		retentionConfig: retentionConfig,
+		rateLimitConfig: rateLimitConfig,
+		running:         newRunningTasks(),
 	}
 }
 
-// CreateTranslationRequest creates a new translation request and sends it to the queue
-func (s *Service) CreateTranslationRequest(ctx context.Context, sourceData map[string]string, languages []string) (*translation.TranslationRequest, error) {
+// CreateTranslationRequest creates a new translation request and sends it to the queue. Existing request
+// returned for a replayed idempotency key or coalesced identical request is not queued again.
+func (s *Service) CreateTranslationRequest(ctx context.Context, sourceData map[string]string, languages []string, options translation.RequestOptions) (*translation.CreateTranslationRequestResult, error) {
 	// Create request in domain
-	request, err := s.domainService.CreateTranslationRequest(ctx, sourceData, languages)
+	result, err := s.domainService.CreateTranslationRequest(ctx, sourceData, languages, options)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create translation request: %w", err)
+		return nil, err
 	}
-
-	// Create task for RabbitMQ
-	task := &rabbitmq.TranslationTask{
-		RequestID:  request.ID,
-		SourceData: sourceData,
-		Languages:  languages,
+	if !result.Created {
+		return result, nil
 	}
+	request := result.Request
 
 	// Send task to queue
-	if err := s.rabbitService.PublishTask(ctx, task); err != nil {
+	if err := s.taskQueue.PublishTask(ctx, requestTask(request)); err != nil {
 		// If failed to send to queue, mark request as failed
 		request.MarkAsFailed()
 		s.domainService.GetRepository().UpdateRequestStatus(ctx, request.ID, request.Status)
+		s.notifyStatus(ctx, request.ID, request.Status)
+		// Creating the request again with the same idempotency key is retried rather than replayed
+		s.domainService.ReleaseRequestKeys(ctx, request, options)
 		return nil, fmt.Errorf("failed to publish task to queue: %w", err)
 	}
 
-	return request, nil
+	return result, nil
+}
+
+// SaveIdempotentResponse saves response to request created with idempotency key of options, so requests
+// repeating the key get it again
+func (s *Service) SaveIdempotentResponse(ctx context.Context, options translation.RequestOptions, response *translation.IdempotentResponse) error {
+	return s.domainService.SaveIdempotentResponse(ctx, options, response)
 }
 
 // GetTranslationRequest gets request by ID
@@ -75,7 +156,18 @@ func (s *Service) GetTranslatedDataForRequestKeys(ctx context.Context, requestKe
 }
 
 // ProcessTranslationTask processes translation task (called by consumer)
-func (s *Service) ProcessTranslationTask(ctx context.Context, task *rabbitmq.TranslationTask) error {
+func (s *Service) ProcessTranslationTask(ctx context.Context, task *translation.TranslationTask) error {
+	// Attribute all key changes made while processing to this request
+	ctx = translation.WithChangeRequestID(ctx, task.RequestID)
+
+	if task.IsChunk() {
+		if err := s.processChunk(ctx, task); err != nil {
+			return err
+		}
+		s.finishChunk(ctx, task)
+		return nil
+	}
+
 	log.Printf("Starting to process translation task for request ID: %s", task.RequestID)
 
 	// Check if request was cancelled before starting
@@ -84,15 +176,25 @@ func (s *Service) ProcessTranslationTask(ctx context.Context, task *rabbitmq.Tra
 		return fmt.Errorf("failed to get request: %w", err)
 	}
 
-	if request.Status == translation.StatusCancelled {
-		log.Printf("Request %s was cancelled, skipping processing", task.RequestID)
+	// Duplicate tasks of finished requests are dropped too
+	if request.Status.IsFinal() {
+		log.Printf("Request %s is %s, skipping processing", task.RequestID, request.Status)
 		return nil
 	}
 
 	// Process request in domain (this will mark as processing if not already)
 	if err := s.domainService.ProcessTranslationRequest(ctx, task.RequestID); err != nil {
+		s.notifyCurrentStatus(ctx, task.RequestID)
 		return fmt.Errorf("failed to process translation request: %w", err)
 	}
+	s.notifyStatus(ctx, task.RequestID, translation.StatusProcessing)
+	s.renewLease(ctx, task.RequestID)
+
+	// Request processed before, e.g. by a worker that died, resumes from its checkpoint
+	checkpoint, err := s.domainService.GetCheckpoint(ctx, task.RequestID)
+	if err != nil {
+		return err
+	}
 
 	// Get keys that require translation for the specific request keys and languages
 	pendingKeys, err := s.domainService.GetPendingTranslationKeysForRequest(ctx, task.SourceData, task.Languages)
@@ -100,7 +202,14 @@ func (s *Service) ProcessTranslationTask(ctx context.Context, task *rabbitmq.Tra
 		return fmt.Errorf("failed to get pending translation keys: %w", err)
 	}
 
-	if len(pendingKeys) == 0 {
+	// Keys that already have all requested translations are reused from cache
+	s.recordCachedKeys(ctx, task, pendingKeys, checkpoint)
+
+	// Fan out the rest into chunks of one language, languages pending keys already have are reused too
+	chunks, cached := translation.PlanChunks(pendingKeys, task.Languages, s.workerConfig.ChunkSize, checkpoint)
+	s.recordKeyResults(ctx, task.RequestID, nil, cached)
+
+	if len(chunks) == 0 {
 		log.Printf("No pending translation keys found for request ID: %s - all translations already exist in cache", task.RequestID)
 		// Mark as completed since no translations needed
 		if err := s.CompleteTranslationRequest(ctx, task.RequestID); err != nil {
@@ -109,40 +218,75 @@ func (s *Service) ProcessTranslationTask(ctx context.Context, task *rabbitmq.Tra
 		return nil
 	}
 
-	log.Printf("Found %d keys that need translation for request ID: %s", len(pendingKeys), task.RequestID)
+	planID := uuid.New()
+	if err := s.domainService.StartChunks(ctx, task.RequestID, planID, len(chunks)); err != nil {
+		return err
+	}
 
-	// Generate translations for each key
-	for i, key := range pendingKeys {
-		// Check if request was cancelled before processing each key
-		request, err := s.domainService.GetTranslationRequest(ctx, task.RequestID)
-		if err != nil {
-			log.Printf("Failed to get request status for ID %s: %v", task.RequestID, err)
-			continue
-		}
+	// Chunks wait in project backlog and are queued as the project gets room
+	if err := s.domainService.ScheduleChunks(ctx, request, planID, chunks); err != nil {
+		return err
+	}
+	s.dispatchChunks(ctx, request.Project)
 
-		if request.Status == translation.StatusCancelled {
-			log.Printf("Request %s was cancelled, stopping translation process", task.RequestID)
-			return nil
-		}
+	log.Printf("Split %d keys of request ID %s into %d chunks", len(pendingKeys), task.RequestID, len(chunks))
+	return nil
+}
 
-		log.Printf("Translating key %d/%d: %s for request ID: %s", i+1, len(pendingKeys), key.Key, task.RequestID)
+// processChunk translates keys of chunk task and completes the request once its last chunk is done
+func (s *Service) processChunk(ctx context.Context, task *translation.TranslationTask) error {
+	language := task.Languages[0]
+	log.Printf("Translating chunk %d (%d keys to %s) of request ID: %s", task.Chunk, len(task.Keys), language, task.RequestID)
 
-		if err := s.translateKey(ctx, key, task.Languages, task.RequestID); err != nil {
-			if err.Error() == "request was cancelled" {
-				log.Printf("Translation cancelled for request %s", task.RequestID)
-				return nil
-			}
-			log.Printf("Failed to translate key %s: %v", key.Key, err)
-			continue
+	// Request processing that was resumed replaced the plan, its chunks cover keys of this one
+	planID, err := s.domainService.GetChunkPlan(ctx, task.RequestID)
+	if err != nil {
+		return err
+	}
+	if planID == nil || *planID != *task.PlanID {
+		log.Printf("Chunk %d of request ID %s belongs to a replaced plan, skipping", task.Chunk, task.RequestID)
+		return nil
+	}
+
+	// Cancellation of request stops translation of the chunk, including the provider call in progress
+	workCtx, done := s.running.track(ctx, task.RequestID)
+	defer done()
+
+	// Check if request was cancelled before starting, later cancellations are broadcast
+	request, err := s.domainService.GetTranslationRequest(ctx, task.RequestID)
+	if err != nil {
+		return fmt.Errorf("failed to get request: %w", err)
+	}
+	if request.Status.IsFinal() {
+		log.Printf("Request %s is %s, skipping chunk %d", task.RequestID, request.Status, task.Chunk)
+		return nil
+	}
+
+	for _, keyName := range task.Keys {
+		if workCtx.Err() != nil {
+			log.Printf("Request %s was cancelled, stopping translation of chunk %d", task.RequestID, task.Chunk)
+			return nil
 		}
 
-		// Save updated key
-		if err := s.domainService.GetRepository().SaveTranslationKey(ctx, key); err != nil {
-			log.Printf("Failed to save translated key %s: %v", key.Key, err)
+		key, result := s.translateKey(workCtx, keyName, language)
+		if result.Outcome == translation.KeyOutcomeFailed && isCancelled(workCtx) {
+			// Key was interrupted rather than failed, it keeps no outcome
+			log.Printf("Request %s was cancelled, stopping translation of chunk %d", task.RequestID, task.Chunk)
+			return nil
 		}
+		s.recordKeyResults(ctx, task.RequestID, key, []*translation.KeyResult{result})
+		s.renewLease(ctx, task.RequestID)
+	}
+
+	last, err := s.domainService.CompleteChunk(ctx, task.RequestID, *task.PlanID, task.Chunk)
+	if err != nil {
+		return err
+	}
+	if !last {
+		return nil
 	}
 
-	// Mark as completed after all translations are done
+	// Requests cancelled while the last chunk was translated stay cancelled
 	if err := s.CompleteTranslationRequest(ctx, task.RequestID); err != nil {
 		log.Printf("Failed to mark request as completed: %v", err)
 	}
@@ -151,64 +295,155 @@ func (s *Service) ProcessTranslationTask(ctx context.Context, task *rabbitmq.Tra
 	return nil
 }
 
-// translateKey translates one key to all specified languages
-func (s *Service) translateKey(ctx context.Context, key *translation.TranslationKey, languages []string, requestID uuid.UUID) error {
+// translateKey translates stored key to language and saves the translation.
+// Returns the key with its current translations, nil if it could not be loaded, and the outcome.
+func (s *Service) translateKey(ctx context.Context, keyName string, language string) (*translation.TranslationKey, *translation.KeyResult) {
 	// Assume source language is English (can be made configurable)
 	sourceLanguage := "en"
 
-	for _, targetLang := range languages {
-		// Check if request was cancelled before each translation
-		request, err := s.domainService.GetTranslationRequest(ctx, requestID)
-		if err != nil {
-			log.Printf("Failed to get request status for ID %s: %v", requestID, err)
-			continue
-		}
+	key, err := s.domainService.GetRepository().GetTranslationKey(ctx, keyName)
+	if err != nil {
+		log.Printf("Failed to get translation key %s: %v", keyName, err)
+		return nil, translation.NewKeyResult(keyName, language, translation.KeyOutcomeFailed, err)
+	}
 
-		if request.Status == translation.StatusCancelled {
-			log.Printf("Request %s was cancelled, stopping translation of key %s", requestID, key.Key)
-			return fmt.Errorf("request was cancelled")
-		}
+	// Another request may have translated the key in the meantime
+	if _, exists := key.Translations[language]; exists {
+		log.Printf("Translation for key %s to %s already exists, skipping", keyName, language)
+		return key, translation.NewKeyResult(keyName, language, translation.KeyOutcomeCached, nil)
+	}
 
-		// Skip if translation already exists
-		if _, exists := key.Translations[targetLang]; exists {
-			log.Printf("Translation for key %s to %s already exists, skipping", key.Key, targetLang)
+	// Create translation request
+	translationReq := &openai.TranslationRequest{
+		Text:     key.Value,
+		FromLang: sourceLanguage,
+		ToLang:   language,
+		Context:  fmt.Sprintf("Translation key: %s", keyName),
+	}
+
+	// Perform translation
+	resp, err := s.openaiService.Translate(ctx, translationReq)
+	if err != nil {
+		log.Printf("Failed to translate key %s to %s: %v", keyName, language, err)
+		return key, translation.NewKeyResult(keyName, language, translation.KeyOutcomeFailed, err)
+	}
+
+	// Clean up the translated text - remove extra quotes
+	translatedText := strings.Trim(resp.TranslatedText, `"'`)
+
+	// Translation finished just as request was cancelled is not saved
+	if err := ctx.Err(); err != nil {
+		return key, translation.NewKeyResult(keyName, language, translation.KeyOutcomeFailed, err)
+	}
+
+	// Save translation
+	keyCtx := translation.WithChangeProvider(ctx, resp.Provider, resp.Model)
+	if err := s.domainService.SaveKeyTranslation(keyCtx, keyName, language, translatedText); err != nil {
+		log.Printf("Failed to save translated key %s: %v", keyName, err)
+		return key, translation.NewKeyResult(keyName, language, translation.KeyOutcomeFailed, fmt.Errorf("failed to save translation: %w", err))
+	}
+
+	key.Translations[language] = translatedText
+	log.Printf("Translated key %s to %s: %s -> %s", keyName, language, key.Value, translatedText)
+	return key, translation.NewKeyResult(keyName, language, translation.KeyOutcomeTranslated, nil)
+}
+
+// recordCachedKeys records request keys that need no translation as reused from cache, unless checkpoint
+// has them done already
+func (s *Service) recordCachedKeys(ctx context.Context, task *translation.TranslationTask, pendingKeys []*translation.TranslationKey, checkpoint translation.Checkpoint) {
+	pending := make(map[string]bool, len(pendingKeys))
+	for _, key := range pendingKeys {
+		pending[key.Key] = true
+	}
+
+	var results []*translation.KeyResult
+	for keyName := range task.SourceData {
+		// Skip keys starting with @ and keys that will be translated
+		if strings.HasPrefix(keyName, "@") || pending[keyName] {
 			continue
 		}
-
-		// Create translation request
-		translationReq := &openai.TranslationRequest{
-			Text:     key.Value,
-			FromLang: sourceLanguage,
-			ToLang:   targetLang,
-			Context:  fmt.Sprintf("Translation key: %s", key.Key),
+		for _, lang := range task.Languages {
+			if !checkpoint.Done(keyName, lang) {
+				results = append(results, translation.NewKeyResult(keyName, lang, translation.KeyOutcomeCached, nil))
+			}
 		}
+	}
 
-		// Perform translation
-		resp, err := s.openaiService.Translate(ctx, translationReq)
-		if err != nil {
-			log.Printf("Failed to translate key %s to %s: %v", key.Key, targetLang, err)
-			continue
+	s.recordKeyResults(ctx, task.RequestID, nil, results)
+}
+
+// recordKeyResults records key outcomes and publishes them as events, failing to record doesn't stop processing.
+// Key holds translations of the results, nil if they are not loaded.
+func (s *Service) recordKeyResults(ctx context.Context, requestID uuid.UUID, key *translation.TranslationKey, results []*translation.KeyResult) {
+	if err := s.domainService.RecordKeyResults(ctx, requestID, results); err != nil {
+		log.Printf("Failed to record key results for request ID %s: %v", requestID, err)
+	}
+
+	for _, result := range results {
+		var value string
+		if key != nil && result.Outcome != translation.KeyOutcomeFailed {
+			value = key.Translations[result.Language]
 		}
+		s.publishEvent(ctx, translation.NewKeyEvent(requestID, result, value))
+	}
+}
 
-		// Clean up the translated text - remove extra quotes
-		translatedText := resp.TranslatedText
-		translatedText = strings.Trim(translatedText, `"'`)
+// notifyStatus publishes status transition of request and queues webhook deliveries of final statuses
+func (s *Service) notifyStatus(ctx context.Context, requestID uuid.UUID, status translation.RequestStatus) {
+	s.publishEvent(ctx, translation.NewStatusEvent(requestID, status))
+	if status.IsFinal() {
+		s.queueWebhooks(ctx, requestID, status)
+	}
+}
 
-		// Save translation
-		key.Translations[targetLang] = translatedText
-		log.Printf("Translated key %s to %s: %s -> %s", key.Key, targetLang, key.Value, translatedText)
+// notifyCurrentStatus notifies about stored status of request, used when status was changed by the domain
+func (s *Service) notifyCurrentStatus(ctx context.Context, requestID uuid.UUID) {
+	request, err := s.domainService.GetTranslationRequest(ctx, requestID)
+	if err != nil {
+		log.Printf("Failed to get request status for ID %s: %v", requestID, err)
+		return
 	}
 
-	return nil
+	s.notifyStatus(ctx, requestID, request.Status)
 }
 
-// StartConsumer starts consumer for task processing
+// publishEvent publishes request event, events are best effort and never fail processing
+func (s *Service) publishEvent(ctx context.Context, event *translation.RequestEvent) {
+	if err := s.eventBus.PublishEvent(ctx, event); err != nil {
+		log.Printf("Failed to publish %s event for request ID %s: %v", event.Type, event.RequestID, err)
+	}
+}
+
+// SubscribeRequestEvents subscribes to progress events of request
+func (s *Service) SubscribeRequestEvents(ctx context.Context, requestID uuid.UUID) (<-chan *translation.RequestEvent, func(), error) {
+	return s.eventBus.SubscribeEvents(ctx, requestID)
+}
+
+// StartConsumer processes queued tasks until ctx is done. No new tasks are taken after that, while
+// tasks in progress run to completion, so it returns once they are finished.
 func (s *Service) StartConsumer(ctx context.Context) error {
-	return s.rabbitService.ConsumeTasks(ctx, func(task *rabbitmq.TranslationTask) error {
-		return s.ProcessTranslationTask(ctx, task)
+	// Tasks in progress must not be aborted halfway when consumer is stopped
+	taskCtx := context.WithoutCancel(ctx)
+
+	// Cancellations are watched while tasks in progress finish
+	watchCtx, stopWatching := context.WithCancel(taskCtx)
+	defer stopWatching()
+	if err := s.watchCancellations(watchCtx); err != nil {
+		return err
+	}
+
+	return s.taskQueue.ConsumeTasks(ctx, s.workerConfig.Concurrency, func(task *translation.TranslationTask) error {
+		return s.ProcessTranslationTask(taskCtx, task)
+	}, func(task *translation.TranslationTask, err error) {
+		s.failDeadLetteredRequest(taskCtx, task, err)
 	})
 }
 
+// GetTranslationKey gets translation key with its translations
+func (s *Service) GetTranslationKey(ctx context.Context, key string) (*translation.TranslationKey, error) {
+	return s.domainService.GetTranslationKey(ctx, key)
+}
+
 // DeleteTranslationKey deletes translation key and all its translations
 func (s *Service) DeleteTranslationKey(ctx context.Context, key string) error {
 	return s.domainService.DeleteTranslationKey(ctx, key)
@@ -219,59 +454,67 @@ func (s *Service) CacheTranslations(ctx context.Context, translations map[string
 	return s.domainService.CacheTranslations(ctx, translations)
 }
 
-// CancelTranslationRequest cancels a translation request
-func (s *Service) CancelTranslationRequest(ctx context.Context, requestID uuid.UUID) error {
-	return s.domainService.CancelTranslationRequest(ctx, requestID)
+// GetKeyHistory gets history of translation key, optionally filtered by language
+func (s *Service) GetKeyHistory(ctx context.Context, key string, language string) ([]*translation.HistoryEntry, error) {
+	return s.domainService.GetKeyHistory(ctx, key, language)
 }
 
-// CompleteTranslationRequest marks a translation request as completed
-func (s *Service) CompleteTranslationRequest(ctx context.Context, requestID uuid.UUID) error {
-	return s.domainService.CompleteTranslationRequest(ctx, requestID)
+// RollbackTranslations restores source values and translations to their state at given time
+func (s *Service) RollbackTranslations(ctx context.Context, key string, language string, at time.Time) (*translation.RollbackResult, error) {
+	return s.domainService.RollbackTranslations(ctx, key, language, at)
 }
 
-// GetIncompleteRequests gets all requests that are not completed, failed, or cancelled
-func (s *Service) GetIncompleteRequests(ctx context.Context) ([]*translation.TranslationRequest, error) {
-	return s.domainService.GetIncompleteRequests(ctx)
+// GetChangesSince gets net changes recorded after sequence, optionally limited to languages
+func (s *Service) GetChangesSince(ctx context.Context, sequence int64, languages []string) (*translation.Delta, error) {
+	return s.domainService.GetChangesSince(ctx, sequence, languages)
 }
 
-// RecoverIncompleteRequests recovers and processes incomplete requests on server startup
-func (s *Service) RecoverIncompleteRequests(ctx context.Context) error {
-	log.Printf("Starting recovery of incomplete translation requests...")
+// GetChangesSinceTime gets net changes recorded after given time, optionally limited to languages
+func (s *Service) GetChangesSinceTime(ctx context.Context, at time.Time, languages []string) (*translation.Delta, error) {
+	return s.domainService.GetChangesSinceTime(ctx, at, languages)
+}
 
-	incompleteRequests, err := s.GetIncompleteRequests(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get incomplete requests: %w", err)
-	}
+// GetRequestProgress gets translation progress and key outcomes of request
+func (s *Service) GetRequestProgress(ctx context.Context, request *translation.TranslationRequest) (*translation.RequestProgress, error) {
+	return s.domainService.GetRequestProgress(ctx, request)
+}
 
-	if len(incompleteRequests) == 0 {
-		log.Printf("No incomplete requests found")
-		return nil
+// CompleteTranslationRequest marks a translation request as completed or partially completed
+func (s *Service) CompleteTranslationRequest(ctx context.Context, requestID uuid.UUID) error {
+	completed, err := s.domainService.CompleteTranslationRequest(ctx, requestID)
+	if err != nil || !completed {
+		return err
 	}
 
-	log.Printf("Found %d incomplete requests to recover", len(incompleteRequests))
-
-	for _, request := range incompleteRequests {
-		log.Printf("Recovering request ID: %s (status: %s)", request.ID, request.Status)
+	s.notifyCurrentStatus(ctx, requestID)
+	return nil
+}
 
-		// Create task for RabbitMQ
-		task := &rabbitmq.TranslationTask{
-			RequestID:  request.ID,
-			SourceData: request.SourceData,
-			Languages:  request.Languages,
-		}
+// PlanRetry finds key languages of finished request that retrying it with options translates again
+func (s *Service) PlanRetry(ctx context.Context, requestID uuid.UUID, options translation.RetryOptions) (*translation.RetryPlan, error) {
+	return s.domainService.PlanRetry(ctx, requestID, options)
+}
 
-		// Send task to queue
-		if err := s.rabbitService.PublishTask(ctx, task); err != nil {
-			log.Printf("Failed to publish recovery task for request ID %s: %v", request.ID, err)
-			// Mark as failed if we can't queue it
-			request.MarkAsFailed()
-			s.domainService.GetRepository().UpdateRequestStatus(ctx, request.ID, request.Status)
-			continue
-		}
+// RetryTranslationRequest queues finished request again to translate its failed and missing key languages,
+// and the ones options force
+func (s *Service) RetryTranslationRequest(ctx context.Context, requestID uuid.UUID, options translation.RetryOptions) (*translation.RetryResult, error) {
+	result, err := s.domainService.RetryTranslationRequest(ctx, requestID, options)
+	if err != nil {
+		return nil, err
+	}
 
-		log.Printf("Successfully queued recovery task for request ID: %s", request.ID)
+	if err := s.taskQueue.PublishTask(ctx, requestTask(result.Request)); err != nil {
+		// Keep the request failed, so retry can be repeated
+		s.failRequest(ctx, requestID, fmt.Sprintf("failed to publish task to queue: %v", err))
+		return nil, fmt.Errorf("failed to publish task to queue: %w", err)
 	}
 
-	log.Printf("Recovery of incomplete requests completed")
-	return nil
+	s.notifyStatus(ctx, requestID, translation.StatusPending)
+	log.Printf("Retrying %d key languages of request ID: %s", result.Retried, requestID)
+	return result, nil
+}
+
+// GetIncompleteRequests gets all requests that are not completed, failed, or cancelled
+func (s *Service) GetIncompleteRequests(ctx context.Context) ([]*translation.TranslationRequest, error) {
+	return s.domainService.GetIncompleteRequests(ctx)
 }
@@ -0,0 +1,105 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"translation/internal/domain/translation"
+)
+
+// TranslateSync creates translation request and processes it inline instead of queueing it: cached keys
+// are reused and the rest are translated and saved like queued requests are. Returns the finished request.
+// When timeout passes first, the request is failed, and translations saved until then are kept. Cancelling
+// the request stops its translation like it stops workers, and the cancelled request is returned.
+func (s *Service) TranslateSync(ctx context.Context, sourceData map[string]string, languages []string, options translation.RequestOptions, timeout time.Duration) (*translation.TranslationRequest, error) {
+	// Only processes that translate have a translator
+	if s.openaiService == nil {
+		return nil, fmt.Errorf("synchronous translation is not available")
+	}
+
+	result, err := s.domainService.CreateTranslationRequest(ctx, sourceData, languages, options)
+	if err != nil {
+		return nil, err
+	}
+	request := result.Request
+	task := requestTask(request)
+
+	// Attribute all key changes made while processing to this request
+	ctx = translation.WithChangeRequestID(ctx, request.ID)
+	// Cancellation broadcast stops translation like it stops tasks of queued requests
+	workCtx, done := s.running.track(ctx, request.ID)
+	defer done()
+	workCtx, cancel := context.WithTimeout(workCtx, timeout)
+	defer cancel()
+
+	if err := s.domainService.ProcessTranslationRequest(ctx, request.ID); err != nil {
+		s.notifyCurrentStatus(ctx, request.ID)
+		return nil, fmt.Errorf("failed to process translation request: %w", err)
+	}
+	s.notifyStatus(ctx, request.ID, translation.StatusProcessing)
+	// Workers starting meanwhile don't take the request for one whose worker died
+	s.renewLease(ctx, request.ID)
+
+	pendingKeys, err := s.domainService.GetPendingTranslationKeysForRequest(ctx, sourceData, languages)
+	if err != nil {
+		s.failRequest(ctx, request.ID, fmt.Sprintf("failed to get pending translation keys: %v", err))
+		return nil, fmt.Errorf("failed to get pending translation keys: %w", err)
+	}
+
+	s.recordCachedKeys(ctx, task, pendingKeys, nil)
+
+	// Every key language is translated on its own, so they run in parallel
+	chunks, cached := translation.PlanChunks(pendingKeys, languages, 1, nil)
+	s.recordKeyResults(ctx, request.ID, nil, cached)
+	s.translateChunksInline(workCtx, ctx, request, chunks)
+
+	if workCtx.Err() != nil && !isCancelled(workCtx) {
+		s.failRequest(ctx, request.ID, "synchronous translation timed out")
+		return nil, fmt.Errorf("synchronous translation timed out")
+	}
+
+	// Requests cancelled while they were translated stay cancelled
+	if err := s.CompleteTranslationRequest(ctx, request.ID); err != nil {
+		return nil, fmt.Errorf("failed to complete translation request: %w", err)
+	}
+
+	return s.domainService.GetTranslationRequest(ctx, request.ID)
+}
+
+// translateChunksInline translates chunks with up to worker concurrency calls at once until workCtx is done,
+// recording outcomes with ctx. Keys interrupted by workCtx keep no outcome.
+func (s *Service) translateChunksInline(workCtx context.Context, ctx context.Context, request *translation.TranslationRequest, chunks []*translation.TranslationChunk) {
+	concurrency := s.workerConfig.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	slots := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for _, chunk := range chunks {
+		for _, keyName := range chunk.Keys {
+			select {
+			case slots <- struct{}{}:
+			case <-workCtx.Done():
+				wg.Wait()
+				return
+			}
+
+			wg.Add(1)
+			go func(keyName string, language string) {
+				defer wg.Done()
+				defer func() { <-slots }()
+
+				key, result := s.translateKey(workCtx, keyName, language)
+				if result.Outcome == translation.KeyOutcomeFailed && workCtx.Err() != nil {
+					return
+				}
+				s.recordKeyResults(ctx, request.ID, key, []*translation.KeyResult{result})
+			}(keyName, chunk.Language)
+		}
+	}
+
+	wg.Wait()
+}
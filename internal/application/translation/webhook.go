@@ -0,0 +1,220 @@
+package translation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+)
+
+// webhookBatchSize is maximum number of deliveries attempted concurrently
+const webhookBatchSize = 10
+
+// WebhookSender defines interface of the webhook HTTP client
+type WebhookSender interface {
+	// Post payload with headers to URL and return response status code
+	Send(ctx context.Context, url string, headers map[string]string, payload []byte) (int, error)
+}
+
+// WebhookPayload represents JSON body posted to webhook receivers
+type WebhookPayload struct {
+	Event       translation.WebhookEvent  `json:"event"`
+	RequestID   uuid.UUID                 `json:"request_id"`
+	Project     string                    `json:"project,omitempty"`
+	Status      translation.RequestStatus `json:"status"`
+	Languages   []string                  `json:"languages"`
+	Total       int                       `json:"total"`
+	Done        int                       `json:"done"`
+	Failed      int                       `json:"failed"`
+	Skipped     int                       `json:"skipped"`
+	CompletedAt *time.Time                `json:"completed_at,omitempty"`
+	CreatedAt   time.Time                 `json:"created_at"`
+}
+
+// CreateWebhook registers webhook receiving events of project requests
+func (s *Service) CreateWebhook(ctx context.Context, project string, url string, secret string, events []translation.WebhookEvent) (*translation.Webhook, error) {
+	return s.domainService.CreateWebhook(ctx, project, url, secret, events)
+}
+
+// GetWebhook gets webhook
+func (s *Service) GetWebhook(ctx context.Context, id uuid.UUID) (*translation.Webhook, error) {
+	return s.domainService.GetWebhook(ctx, id)
+}
+
+// GetWebhooks gets webhooks of project, all webhooks when project is nil
+func (s *Service) GetWebhooks(ctx context.Context, project *string) ([]*translation.Webhook, error) {
+	return s.domainService.GetWebhooks(ctx, project)
+}
+
+// DeleteWebhook deletes webhook
+func (s *Service) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	return s.domainService.DeleteWebhook(ctx, id)
+}
+
+// GetDeliveries gets delivery log of request or webhook
+func (s *Service) GetDeliveries(ctx context.Context, requestID *uuid.UUID, webhookID *uuid.UUID) ([]*translation.WebhookDelivery, error) {
+	return s.domainService.GetDeliveries(ctx, requestID, webhookID)
+}
+
+// GetDelivery gets webhook delivery by ID
+func (s *Service) GetDelivery(ctx context.Context, id uuid.UUID) (*translation.WebhookDelivery, error) {
+	return s.domainService.GetDelivery(ctx, id)
+}
+
+// RedeliverWebhook sends delivery payload once more right away, regardless of its status
+func (s *Service) RedeliverWebhook(ctx context.Context, id uuid.UUID) (*translation.WebhookDelivery, error) {
+	delivery, err := s.domainService.GetDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Manual redelivery is a single attempt, it is not retried automatically
+	s.attemptDelivery(ctx, delivery, len(delivery.Attempts)+1)
+
+	if err := s.domainService.SaveDelivery(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("failed to save delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// queueWebhooks creates deliveries of final status event to project webhooks and request callback URL
+func (s *Service) queueWebhooks(ctx context.Context, requestID uuid.UUID, status translation.RequestStatus) {
+	event, exists := translation.WebhookEventForStatus(status)
+	if !exists {
+		return
+	}
+
+	request, err := s.domainService.GetTranslationRequest(ctx, requestID)
+	if err != nil {
+		log.Printf("Failed to get request %s for webhooks: %v", requestID, err)
+		return
+	}
+
+	payload := WebhookPayload{
+		Event:       event,
+		RequestID:   request.ID,
+		Project:     request.Project,
+		Status:      status,
+		Languages:   request.Languages,
+		CompletedAt: request.CompletedAt,
+		CreatedAt:   time.Now(),
+	}
+	if progress, err := s.domainService.GetRequestProgress(ctx, request); err == nil {
+		payload.Total = progress.Total
+		payload.Done = progress.Done
+		payload.Failed = progress.Failed
+		payload.Skipped = progress.Skipped
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		log.Printf("Failed to marshal webhook payload for request %s: %v", requestID, err)
+		return
+	}
+
+	deliveries, err := s.domainService.CreateDeliveries(ctx, request, event, data)
+	if err != nil {
+		log.Printf("Failed to queue webhooks for request %s: %v", requestID, err)
+		return
+	}
+
+	if len(deliveries) > 0 {
+		log.Printf("Queued %d webhook deliveries of %s for request ID: %s", len(deliveries), event, requestID)
+	}
+}
+
+// StartWebhookDispatcher starts attempting due webhook deliveries until ctx is done
+func (s *Service) StartWebhookDispatcher(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(s.webhookConfig.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.dispatchDueWebhooks(ctx)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// dispatchDueWebhooks attempts due deliveries in batches until none are due
+func (s *Service) dispatchDueWebhooks(ctx context.Context) {
+	for ctx.Err() == nil {
+		deliveries, err := s.domainService.ClaimDueDeliveries(ctx, webhookBatchSize)
+		if err != nil {
+			log.Printf("Failed to claim webhook deliveries: %v", err)
+			return
+		}
+
+		var wg sync.WaitGroup
+		for _, delivery := range deliveries {
+			wg.Add(1)
+			go func(delivery *translation.WebhookDelivery) {
+				defer wg.Done()
+
+				s.attemptDelivery(ctx, delivery, s.webhookConfig.MaxAttempts)
+				if err := s.domainService.SaveDelivery(ctx, delivery); err != nil {
+					log.Printf("Failed to save webhook delivery %s: %v", delivery.ID, err)
+				}
+			}(delivery)
+		}
+		wg.Wait()
+
+		if len(deliveries) < webhookBatchSize {
+			return
+		}
+	}
+}
+
+// attemptDelivery posts signed delivery payload once and records the attempt
+func (s *Service) attemptDelivery(ctx context.Context, delivery *translation.WebhookDelivery, maxAttempts int) {
+	attempt := &translation.DeliveryAttempt{CreatedAt: time.Now()}
+
+	secret := s.webhookConfig.Secret
+	if delivery.WebhookID != nil {
+		webhook, err := s.domainService.GetWebhook(ctx, *delivery.WebhookID)
+		if err != nil {
+			attempt.Error = fmt.Sprintf("webhook unavailable: %v", err)
+			delivery.Abandon(attempt)
+			return
+		}
+		secret = webhook.Secret
+	}
+
+	timestamp := time.Now().Unix()
+	headers := map[string]string{
+		"X-Webhook-ID":        delivery.ID.String(),
+		"X-Webhook-Event":     string(delivery.Event),
+		"X-Webhook-Timestamp": strconv.FormatInt(timestamp, 10),
+	}
+	if secret != "" {
+		headers["X-Webhook-Signature"] = "sha256=" + translation.SignPayload(secret, timestamp, delivery.Payload)
+	}
+
+	statusCode, err := s.webhookSender.Send(ctx, delivery.URL, headers, delivery.Payload)
+	attempt.StatusCode = statusCode
+	attempt.Duration = time.Since(attempt.CreatedAt)
+	if err != nil {
+		attempt.Error = err.Error()
+	} else if !attempt.Succeeded() {
+		attempt.Error = fmt.Sprintf("unexpected status code %d", statusCode)
+	}
+
+	delivery.RecordAttempt(attempt, maxAttempts, s.webhookConfig.RetryBaseDelay)
+	if !attempt.Succeeded() {
+		log.Printf("Webhook delivery %s to %s failed (attempt %d): %s", delivery.ID, delivery.URL, len(delivery.Attempts), attempt.Error)
+	}
+}
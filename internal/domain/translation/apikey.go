@@ -0,0 +1,273 @@
+package translation
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Permission represents operation an API key is allowed to perform
+type Permission string
+
+const (
+	// PermissionTranslate allows creating, cancelling and retrying translation requests
+	PermissionTranslate Permission = "translate"
+	// PermissionRead allows reading requests, translations, history and releases
+	PermissionRead Permission = "read"
+	// PermissionCacheWrite allows changing stored translations directly and creating releases
+	PermissionCacheWrite Permission = "cache:write"
+	// PermissionAdmin allows everything, including webhooks, queue administration and API keys
+	PermissionAdmin Permission = "admin"
+)
+
+// Permissions lists all permissions
+var Permissions = []Permission{PermissionTranslate, PermissionRead, PermissionCacheWrite, PermissionAdmin}
+
+// projectWidePermissions change data shared by all projects, so project-scoped keys can't have them
+var projectWidePermissions = []Permission{PermissionCacheWrite, PermissionAdmin}
+
+const (
+	// apiKeyTouchInterval is how often last use of an API key is recorded at most
+	apiKeyTouchInterval = time.Minute
+
+	// DefaultAPIKeyRotationOverlap is how long rotated key stays valid unless told otherwise
+	DefaultAPIKeyRotationOverlap = 24 * time.Hour
+)
+
+// APIKey represents managed API key. Only the hash of its secret is stored.
+type APIKey struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Hash is hex SHA-256 of the secret
+	Hash string `json:"hash"`
+	// Prefix is the beginning of the secret that identifies it to people
+	Prefix string `json:"prefix"`
+	// Projects the key is limited to, all projects when empty
+	Projects    []string     `json:"projects,omitempty"`
+	Permissions []Permission `json:"permissions"`
+	CreatedAt   time.Time    `json:"created_at"`
+	ExpiresAt   *time.Time   `json:"expires_at,omitempty"`
+	RevokedAt   *time.Time   `json:"revoked_at,omitempty"`
+	LastUsedAt  *time.Time   `json:"last_used_at,omitempty"`
+	// RotatedFrom is ID of key this key replaced, ReplacedBy is ID of key that replaced this key
+	RotatedFrom string `json:"rotated_from,omitempty"`
+	ReplacedBy  string `json:"replaced_by,omitempty"`
+}
+
+// BootstrapAPIKey returns key configured in environment, it is allowed everything
+func BootstrapAPIKey(id string) *APIKey {
+	return &APIKey{
+		ID:          id,
+		Name:        "bootstrap",
+		Permissions: []Permission{PermissionAdmin},
+	}
+}
+
+// HashAPIKey returns hex SHA-256 of API key secret. Secrets are random, so a fast hash is enough.
+func HashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// Principal returns actions key may perform
+func (k *APIKey) Principal() *Principal {
+	return &Principal{
+		ID:       k.ID,
+		Name:     k.Name,
+		Actions:  grantedActions(permissionActions, k.Permissions),
+		Projects: k.Projects,
+	}
+}
+
+// Active reports whether key can be used at given time
+func (k *APIKey) Active(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// CreateAPIKeyOptions represents settings of new API key
+type CreateAPIKeyOptions struct {
+	Name        string
+	Projects    []string
+	Permissions []Permission
+	// ExpiresAt is nil for keys that don't expire
+	ExpiresAt *time.Time
+}
+
+// newAPIKeySecret generates API key ID and secret, the secret starts with ID so it is recognisable
+func newAPIKeySecret() (string, string, error) {
+	buf := make([]byte, 38)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+
+	id := "key_" + hex.EncodeToString(buf[:6])
+	secret := "tk_" + hex.EncodeToString(buf[:6]) + "_" + hex.EncodeToString(buf[6:])
+	return id, secret, nil
+}
+
+// CreateAPIKey creates API key and returns it with its secret, which is not stored. Keys are only
+// created within access of issuer, nil issuer is unrestricted.
+func (s *Service) CreateAPIKey(ctx context.Context, issuer *Principal, options CreateAPIKeyOptions) (*APIKey, string, error) {
+	if options.Name == "" {
+		return nil, "", fmt.Errorf("api key name is required")
+	}
+	if len(options.Permissions) == 0 {
+		return nil, "", fmt.Errorf("invalid permission")
+	}
+	for _, permission := range options.Permissions {
+		if !slices.Contains(Permissions, permission) {
+			return nil, "", fmt.Errorf("invalid permission")
+		}
+		if len(options.Projects) > 0 && slices.Contains(projectWidePermissions, permission) {
+			return nil, "", fmt.Errorf("project-scoped api key cannot have permission: %s", permission)
+		}
+	}
+	if options.ExpiresAt != nil && !options.ExpiresAt.After(time.Now()) {
+		return nil, "", fmt.Errorf("invalid expiry")
+	}
+
+	id, secret, err := newAPIKeySecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := &APIKey{
+		ID:          id,
+		Name:        options.Name,
+		Hash:        HashAPIKey(secret),
+		Prefix:      secret[:len("tk_")+12],
+		Projects:    options.Projects,
+		Permissions: options.Permissions,
+		CreatedAt:   time.Now(),
+		ExpiresAt:   options.ExpiresAt,
+	}
+	if issuer != nil && !issuer.Covers(key) {
+		return nil, "", fmt.Errorf("api key cannot grant more access than issuer has")
+	}
+
+	if err := s.repo.SaveAPIKey(ctx, key); err != nil {
+		return nil, "", fmt.Errorf("failed to save api key: %w", err)
+	}
+
+	return key, secret, nil
+}
+
+// AuthenticateAPIKey returns active key with given secret and records its use
+func (s *Service) AuthenticateAPIKey(ctx context.Context, secret string) (*APIKey, error) {
+	hash := HashAPIKey(secret)
+	key, err := s.repo.GetAPIKeyByHash(ctx, hash)
+	if err != nil {
+		if err.Error() == "api key not found" {
+			return nil, fmt.Errorf("invalid api key")
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	if subtle.ConstantTimeCompare([]byte(key.Hash), []byte(hash)) != 1 || !key.Active(now) {
+		return nil, fmt.Errorf("invalid api key")
+	}
+
+	// Recording every use would write on every request
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		if err := s.repo.TouchAPIKey(ctx, key.ID, now); err != nil {
+			fmt.Printf("Failed to record use of api key %s: %v\n", key.ID, err)
+		}
+		key.LastUsedAt = &now
+	}
+
+	return key, nil
+}
+
+// GetAPIKey gets API key by ID
+func (s *Service) GetAPIKey(ctx context.Context, id string) (*APIKey, error) {
+	return s.repo.GetAPIKey(ctx, id)
+}
+
+// GetAPIKeys gets API keys ordered by creation time
+func (s *Service) GetAPIKeys(ctx context.Context) ([]*APIKey, error) {
+	keys, err := s.repo.GetAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(keys, func(a, b *APIKey) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return keys, nil
+}
+
+// RevokeAPIKey revokes API key, so it can't be used anymore. Revoked keys are kept for reference.
+func (s *Service) RevokeAPIKey(ctx context.Context, issuer *Principal, id string) (*APIKey, error) {
+	key, err := s.repo.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if issuer != nil && !issuer.Covers(key) {
+		return nil, fmt.Errorf("api key cannot grant more access than issuer has")
+	}
+	if key.RevokedAt != nil {
+		return key, nil
+	}
+
+	now := time.Now()
+	key.RevokedAt = &now
+	if err := s.repo.SaveAPIKey(ctx, key); err != nil {
+		return nil, fmt.Errorf("failed to save api key: %w", err)
+	}
+
+	return key, nil
+}
+
+// RotateAPIKey creates key with the same name, projects, permissions and lifetime replacing given key,
+// which stays valid for overlap so clients can switch. Returns new key with its secret.
+func (s *Service) RotateAPIKey(ctx context.Context, issuer *Principal, id string, overlap time.Duration) (*APIKey, string, error) {
+	key, err := s.repo.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	if !key.Active(now) || key.ReplacedBy != "" {
+		return nil, "", fmt.Errorf("api key is not active")
+	}
+	if overlap < 0 {
+		return nil, "", fmt.Errorf("invalid overlap")
+	}
+
+	options := CreateAPIKeyOptions{
+		Name:        key.Name,
+		Projects:    key.Projects,
+		Permissions: key.Permissions,
+	}
+	if key.ExpiresAt != nil {
+		// New key is valid as long as the old one was meant to be
+		expiresAt := now.Add(key.ExpiresAt.Sub(key.CreatedAt))
+		options.ExpiresAt = &expiresAt
+	}
+
+	rotated, secret, err := s.CreateAPIKey(ctx, issuer, options)
+	if err != nil {
+		return nil, "", err
+	}
+	rotated.RotatedFrom = key.ID
+	if err := s.repo.SaveAPIKey(ctx, rotated); err != nil {
+		return nil, "", fmt.Errorf("failed to save api key: %w", err)
+	}
+
+	until := now.Add(overlap)
+	if key.ExpiresAt == nil || until.Before(*key.ExpiresAt) {
+		key.ExpiresAt = &until
+	}
+	key.ReplacedBy = rotated.ID
+	if err := s.repo.SaveAPIKey(ctx, key); err != nil {
+		return nil, "", fmt.Errorf("failed to save api key: %w", err)
+	}
+
+	return rotated, secret, nil
+}
@@ -0,0 +1,275 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RequestSummary represents compact record of request used for listing. Archived requests are replaced
+// by their summaries, which keep counts of key outcomes instead of source data and results.
+type RequestSummary struct {
+	ID          uuid.UUID         `json:"id"`
+	Status      RequestStatus     `json:"status"`
+	Project     string            `json:"project,omitempty"`
+	CreatedBy   string            `json:"created_by,omitempty"`
+	Languages   []string          `json:"languages"`
+	Keys        int               `json:"keys"`
+	Priority    int               `json:"priority"`
+	Error       string            `json:"error,omitempty"`
+	Progress    *LanguageProgress `json:"progress,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+	CompletedAt *time.Time        `json:"completed_at,omitempty"`
+	ArchivedAt  *time.Time        `json:"archived_at,omitempty"`
+}
+
+// NewRequestSummary creates summary of request, progress is left out when nil
+func NewRequestSummary(request *TranslationRequest, progress *RequestProgress) *RequestSummary {
+	keys := 0
+	for key := range request.SourceData {
+		// Keys starting with @ are metadata and never translated
+		if !strings.HasPrefix(key, "@") {
+			keys++
+		}
+	}
+
+	summary := &RequestSummary{
+		ID:          request.ID,
+		Status:      request.Status,
+		Project:     request.Project,
+		CreatedBy:   request.CreatedBy,
+		Languages:   request.Languages,
+		Keys:        keys,
+		Priority:    request.Priority,
+		Error:       request.Error,
+		CreatedAt:   request.CreatedAt,
+		UpdatedAt:   request.UpdatedAt,
+		CompletedAt: request.CompletedAt,
+	}
+	if progress != nil {
+		overall := progress.LanguageProgress
+		summary.Progress = &overall
+	}
+
+	return summary
+}
+
+// RequestSort represents field requests are listed by
+type RequestSort string
+
+const (
+	RequestSortCreatedAt RequestSort = "created_at"
+	RequestSortUpdatedAt RequestSort = "updated_at"
+	RequestSortPriority  RequestSort = "priority"
+)
+
+// Limits of one page of listed requests
+const (
+	DefaultRequestPageSize = 50
+	MaxRequestPageSize     = 500
+)
+
+// requestStatuses are statuses requests can be listed by
+var requestStatuses = map[RequestStatus]bool{
+	StatusPending:            true,
+	StatusProcessing:         true,
+	StatusCompleted:          true,
+	StatusPartiallyCompleted: true,
+	StatusFailed:             true,
+	StatusCancelled:          true,
+}
+
+// RequestFilter selects and orders listed requests, empty fields don't filter
+type RequestFilter struct {
+	Statuses []RequestStatus
+	Project  string
+	// Projects limits requests to some projects, such as those an API key may access
+	Projects  []string
+	CreatedBy string
+	// CreatedFrom and CreatedTo limit creation time, both inclusive
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	// Sort is RequestSortCreatedAt when empty
+	Sort       RequestSort
+	Descending bool
+	Offset     int
+	// Limit is DefaultRequestPageSize when 0
+	Limit int
+}
+
+// matches reports whether summary passes filter
+func (f *RequestFilter) matches(summary *RequestSummary) bool {
+	if len(f.Statuses) > 0 && !slices.Contains(f.Statuses, summary.Status) {
+		return false
+	}
+	if f.Project != "" && summary.Project != f.Project {
+		return false
+	}
+	if len(f.Projects) > 0 && !slices.Contains(f.Projects, summary.Project) {
+		return false
+	}
+	if f.CreatedBy != "" && summary.CreatedBy != f.CreatedBy {
+		return false
+	}
+	return true
+}
+
+// less reports whether summary a is listed before summary b, ties are broken by creation time and ID
+func (f *RequestFilter) less(a, b *RequestSummary) bool {
+	var cmp int
+	switch f.Sort {
+	case RequestSortUpdatedAt:
+		cmp = a.UpdatedAt.Compare(b.UpdatedAt)
+	case RequestSortPriority:
+		cmp = a.Priority - b.Priority
+	}
+	if cmp == 0 {
+		cmp = a.CreatedAt.Compare(b.CreatedAt)
+	}
+	if cmp == 0 {
+		cmp = strings.Compare(a.ID.String(), b.ID.String())
+	}
+
+	if f.Descending {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+// RequestPage represents one page of listed requests and number of requests matching filter
+type RequestPage struct {
+	Requests []*RequestSummary
+	Total    int
+}
+
+// ListTranslationRequests lists stored and archived requests matching filter
+func (s *Service) ListTranslationRequests(ctx context.Context, filter RequestFilter) (*RequestPage, error) {
+	switch filter.Sort {
+	case "":
+		filter.Sort = RequestSortCreatedAt
+	case RequestSortCreatedAt, RequestSortUpdatedAt, RequestSortPriority:
+	default:
+		return nil, fmt.Errorf("invalid sort")
+	}
+	if filter.Limit == 0 {
+		filter.Limit = DefaultRequestPageSize
+	}
+	if filter.Limit < 0 || filter.Limit > MaxRequestPageSize || filter.Offset < 0 {
+		return nil, fmt.Errorf("invalid page")
+	}
+	for _, status := range filter.Statuses {
+		if _, exists := requestStatuses[status]; !exists {
+			return nil, fmt.Errorf("invalid status")
+		}
+	}
+
+	archived, err := s.repo.GetRequestSummaries(ctx, filter.CreatedFrom, filter.CreatedTo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archived requests: %w", err)
+	}
+	summaries := make(map[uuid.UUID]*RequestSummary, len(archived))
+	for _, summary := range archived {
+		summaries[summary.ID] = summary
+	}
+
+	ids, err := s.repo.GetRequestIDs(ctx, filter.CreatedFrom, filter.CreatedTo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get request IDs: %w", err)
+	}
+	requests := make(map[uuid.UUID]*TranslationRequest, len(ids))
+	for _, id := range ids {
+		request, err := s.repo.GetRequestByID(ctx, id)
+		if err != nil {
+			continue // Request was archived meanwhile
+		}
+		// Request still stored is newer than its summary
+		requests[id] = request
+		summaries[id] = NewRequestSummary(request, nil)
+	}
+
+	var matching []*RequestSummary
+	for _, summary := range summaries {
+		if filter.matches(summary) {
+			matching = append(matching, summary)
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool {
+		return filter.less(matching[i], matching[j])
+	})
+
+	page := &RequestPage{Total: len(matching)}
+	if filter.Offset < len(matching) {
+		page.Requests = matching[filter.Offset:min(filter.Offset+filter.Limit, len(matching))]
+	}
+
+	// Progress is only counted for requests on the page, archived ones keep theirs
+	for i, summary := range page.Requests {
+		request, stored := requests[summary.ID]
+		if !stored {
+			continue
+		}
+		progress, err := s.GetRequestProgress(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		page.Requests[i] = NewRequestSummary(request, progress)
+	}
+
+	return page, nil
+}
+
+// ArchiveRequests replaces requests that reached a final status before given time with their summaries,
+// removing source data and key outcomes. Returns number of archived requests.
+func (s *Service) ArchiveRequests(ctx context.Context, finishedBefore time.Time) (int, error) {
+	ids, err := s.repo.GetRequestIDs(ctx, time.Time{}, time.Time{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get request IDs: %w", err)
+	}
+
+	archived := 0
+	for _, id := range ids {
+		request, err := s.repo.GetRequestByID(ctx, id)
+		if err != nil {
+			continue // Request was archived meanwhile
+		}
+		if !request.Status.IsFinal() || !request.UpdatedAt.Before(finishedBefore) {
+			continue
+		}
+
+		progress, err := s.GetRequestProgress(ctx, request)
+		if err != nil {
+			return archived, err
+		}
+
+		summary := NewRequestSummary(request, progress)
+		now := time.Now()
+		summary.ArchivedAt = &now
+
+		// Summary is saved first, so request is listed while it is being archived
+		if err := s.repo.SaveRequestSummary(ctx, summary); err != nil {
+			return archived, fmt.Errorf("failed to save request summary: %w", err)
+		}
+		if err := s.repo.DeleteRequest(ctx, id); err != nil {
+			return archived, fmt.Errorf("failed to delete request: %w", err)
+		}
+		archived++
+	}
+
+	return archived, nil
+}
+
+// PruneArchivedRequests deletes summaries of archived requests created before given time,
+// returns number of deleted summaries
+func (s *Service) PruneArchivedRequests(ctx context.Context, createdBefore time.Time) (int, error) {
+	deleted, err := s.repo.DeleteRequestSummariesBefore(ctx, createdBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete request summaries: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,178 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditEntry represents record of one mutating API request. Entries are only ever appended.
+type AuditEntry struct {
+	ID uuid.UUID `json:"id"`
+	// Actor is ID of API key or user that made the request
+	Actor     string `json:"actor"`
+	ActorName string `json:"actor_name,omitempty"`
+	// Action is authorized action of the request, empty for routes without one
+	Action Action `json:"action,omitempty"`
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Target identifies what was changed, such as request ID, key, release name or webhook ID
+	Target  string `json:"target,omitempty"`
+	Project string `json:"project,omitempty"`
+	// Before and After summarize target before and after the change
+	Before    map[string]any `json:"before,omitempty"`
+	After     map[string]any `json:"after,omitempty"`
+	IP        string         `json:"ip"`
+	Status    int            `json:"status"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// Limits of one page of listed audit entries
+const (
+	DefaultAuditPageSize = 100
+	MaxAuditPageSize     = 1000
+)
+
+// auditBatchSize is number of audit entries read from storage at once when filtering or exporting them
+const auditBatchSize = 500
+
+// AuditFilter selects listed audit entries, empty fields don't filter
+type AuditFilter struct {
+	Actor   string
+	Action  Action
+	Target  string
+	Project string
+	// From and To limit time of entries, both inclusive
+	From time.Time
+	To   time.Time
+	// Offset and Limit select page of listed entries, exports include all matching entries
+	Offset int
+	// Limit is DefaultAuditPageSize when 0
+	Limit int
+}
+
+// byTimeOnly reports whether filter selects entries by their time alone
+func (f *AuditFilter) byTimeOnly() bool {
+	return f.Actor == "" && f.Action == "" && f.Target == "" && f.Project == ""
+}
+
+// matches reports whether entry passes filter
+func (f *AuditFilter) matches(entry *AuditEntry) bool {
+	if f.Actor != "" && entry.Actor != f.Actor {
+		return false
+	}
+	if f.Action != "" && entry.Action != f.Action {
+		return false
+	}
+	if f.Target != "" && entry.Target != f.Target {
+		return false
+	}
+	if f.Project != "" && entry.Project != f.Project {
+		return false
+	}
+	return true
+}
+
+// AuditPage represents one page of listed audit entries and number of entries matching filter
+type AuditPage struct {
+	Entries []*AuditEntry
+	Total   int
+}
+
+// RecordAuditEntry appends entry to audit log, assigning its ID and time
+func (s *Service) RecordAuditEntry(ctx context.Context, entry *AuditEntry) error {
+	entry.ID = uuid.New()
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	if err := s.repo.AppendAuditEntry(ctx, entry); err != nil {
+		return fmt.Errorf("failed to append audit entry: %w", err)
+	}
+	return nil
+}
+
+// ListAuditEntries lists audit entries matching filter, newest first
+func (s *Service) ListAuditEntries(ctx context.Context, filter AuditFilter) (*AuditPage, error) {
+	if filter.Limit == 0 {
+		filter.Limit = DefaultAuditPageSize
+	}
+	if filter.Limit < 0 || filter.Limit > MaxAuditPageSize || filter.Offset < 0 {
+		return nil, fmt.Errorf("invalid page")
+	}
+	filter = filter.snapshot()
+
+	// Storage pages entries selected by time alone
+	if filter.byTimeOnly() {
+		total, err := s.repo.CountAuditEntries(ctx, filter.From, filter.To)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count audit entries: %w", err)
+		}
+		entries, err := s.repo.GetAuditEntries(ctx, filter.From, filter.To, true, filter.Offset, filter.Limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get audit entries: %w", err)
+		}
+		return &AuditPage{Entries: entries, Total: total}, nil
+	}
+
+	// Other filters are applied to batches of entries, keeping only the listed page
+	page := &AuditPage{}
+	err := s.scanAuditEntries(ctx, filter, true, func(matching []*AuditEntry) error {
+		for _, entry := range matching {
+			if page.Total >= filter.Offset && page.Total < filter.Offset+filter.Limit {
+				page.Entries = append(page.Entries, entry)
+			}
+			page.Total++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// ExportAuditEntries calls fn with all audit entries matching filter, oldest first, a batch at a time. Offset
+// and limit are ignored. Export stops with the error fn returns.
+func (s *Service) ExportAuditEntries(ctx context.Context, filter AuditFilter, fn func([]*AuditEntry) error) error {
+	return s.scanAuditEntries(ctx, filter.snapshot(), false, fn)
+}
+
+// snapshot returns filter ending at current time when it has no end, so entries recorded while listed
+// entries are read don't shift them
+func (f *AuditFilter) snapshot() AuditFilter {
+	snapshot := *f
+	if snapshot.To.IsZero() {
+		snapshot.To = time.Now()
+	}
+	return snapshot
+}
+
+// scanAuditEntries calls fn with audit entries matching filter a batch at a time, newest first when reverse
+// is set, oldest first otherwise. Batches without matching entries are skipped.
+func (s *Service) scanAuditEntries(ctx context.Context, filter AuditFilter, reverse bool, fn func([]*AuditEntry) error) error {
+	for offset := 0; ; offset += auditBatchSize {
+		entries, err := s.repo.GetAuditEntries(ctx, filter.From, filter.To, reverse, offset, auditBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to get audit entries: %w", err)
+		}
+
+		var matching []*AuditEntry
+		for _, entry := range entries {
+			if filter.matches(entry) {
+				matching = append(matching, entry)
+			}
+		}
+		if len(matching) > 0 {
+			if err := fn(matching); err != nil {
+				return err
+			}
+		}
+
+		if len(entries) < auditBatchSize {
+			return nil
+		}
+	}
+}
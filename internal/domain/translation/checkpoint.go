@@ -0,0 +1,125 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// requestLease is how long processing request is considered alive after its last progress.
+// Requests whose lease expired while no chunk of theirs waits in a backlog lost their worker
+// and are processed again from their checkpoint.
+const requestLease = 10 * time.Minute
+
+// Checkpoint represents key languages of request that were already translated or reused from cache,
+// so interrupted processing resumes where it stopped instead of starting from scratch
+type Checkpoint map[string]map[string]bool
+
+// Done reports whether key was already processed successfully for language
+func (c Checkpoint) Done(key string, language string) bool {
+	return c[language][key]
+}
+
+// GetCheckpoint builds checkpoint of request from its recorded key outcomes, failed and discarded keys are not done
+func (s *Service) GetCheckpoint(ctx context.Context, requestID uuid.UUID) (Checkpoint, error) {
+	results, err := s.repo.GetKeyResults(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key results: %w", err)
+	}
+
+	checkpoint := make(Checkpoint)
+	for _, result := range results {
+		if result.Outcome == KeyOutcomeFailed || result.Outcome == KeyOutcomeDiscarded {
+			continue
+		}
+		if checkpoint[result.Language] == nil {
+			checkpoint[result.Language] = make(map[string]bool)
+		}
+		checkpoint[result.Language][result.Key] = true
+	}
+
+	return checkpoint, nil
+}
+
+// GetChunkPlan gets ID of current chunk plan of request, nil when request was not split into chunks
+func (s *Service) GetChunkPlan(ctx context.Context, requestID uuid.UUID) (*uuid.UUID, error) {
+	planID, err := s.repo.GetChunkPlan(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chunk plan: %w", err)
+	}
+	return planID, nil
+}
+
+// RenewRequestLease records progress of request, keeping it from being considered stale for requestLease
+func (s *Service) RenewRequestLease(ctx context.Context, requestID uuid.UUID) error {
+	return s.extendRequestLease(ctx, requestID, time.Now().Add(requestLease))
+}
+
+// extendRequestLease extends lease of request to given time, leases are never shortened
+func (s *Service) extendRequestLease(ctx context.Context, requestID uuid.UUID, until time.Time) error {
+	if err := s.repo.ExtendRequestLease(ctx, requestID, until); err != nil {
+		return fmt.Errorf("failed to extend request lease: %w", err)
+	}
+	return nil
+}
+
+// GetStaleRequests gets processing requests whose worker stopped making progress, see IsRequestStale.
+// Returned requests get a new lease, so they are returned once. Leases of requests that are no longer
+// processing are removed.
+func (s *Service) GetStaleRequests(ctx context.Context) ([]*TranslationRequest, error) {
+	ids, err := s.repo.GetExpiredRequestLeases(ctx, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired request leases: %w", err)
+	}
+
+	var stale []*TranslationRequest
+	for _, id := range ids {
+		request, err := s.repo.GetRequestByID(ctx, id)
+		if err != nil || request.Status != StatusProcessing {
+			if err := s.repo.RemoveRequestLease(ctx, id); err != nil {
+				return nil, fmt.Errorf("failed to remove request lease: %w", err)
+			}
+			continue
+		}
+
+		isStale, err := s.IsRequestStale(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.RenewRequestLease(ctx, id); err != nil {
+			return nil, err
+		}
+		if isStale {
+			stale = append(stale, request)
+		}
+	}
+
+	return stale, nil
+}
+
+// IsRequestStale reports whether processing request lost its worker: its lease is missing or expired
+// and none of its chunks waits in a backlog
+func (s *Service) IsRequestStale(ctx context.Context, request *TranslationRequest) (bool, error) {
+	if request.Status != StatusProcessing {
+		return false, nil
+	}
+
+	until, err := s.repo.GetRequestLease(ctx, request.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get request lease: %w", err)
+	}
+	if until != nil && until.After(time.Now()) {
+		return false, nil
+	}
+
+	// Chunks waiting for their project to get room are not lost
+	scheduled, err := s.repo.CountScheduledChunks(ctx, request.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to count scheduled chunks: %w", err)
+	}
+
+	return scheduled == 0, nil
+}
@@ -0,0 +1,167 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TranslationChunk represents request keys translated to one language by one task
+type TranslationChunk struct {
+	Language string
+	Keys     []string
+}
+
+// PlanChunks splits translation of keys into chunks of at most chunkSize keys of one language.
+// Key languages that already have translations need no work and are returned as cached results.
+// Key languages done according to checkpoint are left out, they have their results already.
+func PlanChunks(keys []*TranslationKey, languages []string, chunkSize int, checkpoint Checkpoint) ([]*TranslationChunk, []*KeyResult) {
+	if chunkSize < 1 {
+		chunkSize = 1
+	}
+
+	// Plan is deterministic, so chunk tasks are easy to follow in logs
+	sorted := make([]*TranslationKey, len(keys))
+	copy(sorted, keys)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Key < sorted[j].Key
+	})
+
+	var chunks []*TranslationChunk
+	var cached []*KeyResult
+	for _, lang := range languages {
+		var chunk *TranslationChunk
+		for _, key := range sorted {
+			if checkpoint.Done(key.Key, lang) {
+				continue
+			}
+			if _, exists := key.Translations[lang]; exists {
+				cached = append(cached, NewKeyResult(key.Key, lang, KeyOutcomeCached, nil))
+				continue
+			}
+
+			if chunk == nil || len(chunk.Keys) == chunkSize {
+				chunk = &TranslationChunk{Language: lang}
+				chunks = append(chunks, chunk)
+			}
+			chunk.Keys = append(chunk.Keys, key.Key)
+		}
+	}
+
+	return chunks, cached
+}
+
+// StartChunks records that request translation was split into count chunks of plan, replacing any earlier plan
+func (s *Service) StartChunks(ctx context.Context, requestID uuid.UUID, planID uuid.UUID, count int) error {
+	if err := s.repo.SaveChunkPlan(ctx, requestID, planID, count); err != nil {
+		return fmt.Errorf("failed to save chunk plan: %w", err)
+	}
+	return nil
+}
+
+// CompleteChunk records chunk of plan as done and reports whether it was the last outstanding one.
+// Chunks of replaced plans and chunks that were already recorded never complete the request.
+func (s *Service) CompleteChunk(ctx context.Context, requestID uuid.UUID, planID uuid.UUID, chunk int) (bool, error) {
+	last, err := s.repo.CompleteChunk(ctx, requestID, planID, chunk)
+	if err != nil {
+		return false, fmt.Errorf("failed to complete chunk: %w", err)
+	}
+	return last, nil
+}
+
+// SaveKeyTranslation sets translation of key to language without touching its other translations,
+// so chunks of different languages can update the same key concurrently, and records the change in history
+func (s *Service) SaveKeyTranslation(ctx context.Context, key string, language string, value string) error {
+	previous, existed, err := s.repo.SetKeyTranslation(ctx, key, language, value)
+	if err != nil {
+		return err
+	}
+
+	before := &TranslationKey{Key: key, Translations: make(map[string]string)}
+	if existed {
+		before.Translations[language] = previous
+	}
+	after := &TranslationKey{Key: key, Translations: map[string]string{language: value}}
+
+	s.recordHistory(ctx, before, after)
+	return nil
+}
+
+// chunkLease is how long a released chunk counts against its project's limit. Chunks whose processing
+// is never reported, e.g. because the worker crashed, stop counting once their lease expires.
+const chunkLease = 30 * time.Minute
+
+// ScheduledChunk represents chunk of request waiting in its project's backlog to be queued
+type ScheduledChunk struct {
+	RequestID uuid.UUID `json:"request_id"`
+	PlanID    uuid.UUID `json:"plan_id"`
+	Index     int       `json:"index"`
+	Language  string    `json:"language"`
+	Keys      []string  `json:"keys"`
+	Priority  int       `json:"priority"`
+}
+
+// ChunkID returns identifier of chunk of plan, unique across plans and requests
+func ChunkID(requestID uuid.UUID, planID uuid.UUID, index int) string {
+	return fmt.Sprintf("%s:%s:%d", requestID, planID, index)
+}
+
+// ID returns identifier of chunk
+func (c *ScheduledChunk) ID() string {
+	return ChunkID(c.RequestID, c.PlanID, c.Index)
+}
+
+// ScheduleChunks adds chunks of request plan to backlog of request project, to be released by ReleaseChunks
+func (s *Service) ScheduleChunks(ctx context.Context, request *TranslationRequest, planID uuid.UUID, chunks []*TranslationChunk) error {
+	scheduled := make([]*ScheduledChunk, 0, len(chunks))
+	for i, chunk := range chunks {
+		scheduled = append(scheduled, &ScheduledChunk{
+			RequestID: request.ID,
+			PlanID:    planID,
+			Index:     i,
+			Language:  chunk.Language,
+			Keys:      chunk.Keys,
+			Priority:  request.Priority,
+		})
+	}
+
+	if err := s.repo.ScheduleChunks(ctx, request.Project, scheduled); err != nil {
+		return fmt.Errorf("failed to schedule chunks: %w", err)
+	}
+	return nil
+}
+
+// ReleaseChunks takes chunks from project backlog, highest priority first, so that at most limit chunks
+// of the project are in flight. Released chunks stay in flight until FinishChunk or their lease expires.
+func (s *Service) ReleaseChunks(ctx context.Context, project string, limit int) ([]*ScheduledChunk, error) {
+	leaseUntil := time.Now().Add(chunkLease)
+	chunks, err := s.repo.ReleaseChunks(ctx, project, limit, leaseUntil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to release chunks: %w", err)
+	}
+
+	// Requests are alive while their chunks are in flight
+	for _, chunk := range chunks {
+		if err := s.extendRequestLease(ctx, chunk.RequestID, leaseUntil); err != nil {
+			fmt.Printf("Failed to extend lease of request %s: %v\n", chunk.RequestID, err)
+		}
+	}
+
+	return chunks, nil
+}
+
+// FinishChunk records that released chunk is no longer in flight, freeing its place for the next one
+func (s *Service) FinishChunk(ctx context.Context, project string, chunkID string) error {
+	if err := s.repo.FinishChunk(ctx, project, chunkID); err != nil {
+		return fmt.Errorf("failed to finish chunk: %w", err)
+	}
+	return nil
+}
+
+// GetScheduledProjects gets projects that have chunks waiting in backlog
+func (s *Service) GetScheduledProjects(ctx context.Context) ([]string, error) {
+	return s.repo.GetScheduledProjects(ctx)
+}
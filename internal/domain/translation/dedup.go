@@ -0,0 +1,193 @@
+package translation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxIdempotencyKeyLength is the longest accepted idempotency key
+	MaxIdempotencyKeyLength = 255
+
+	// idempotencyKeyTTL is how long creating request with a used idempotency key returns the first request
+	idempotencyKeyTTL = 24 * time.Hour
+
+	// coalesceTTL bounds how long requests can be coalesced onto a request, requests in progress
+	// for longer are not deduplicated anymore
+	coalesceTTL = 24 * time.Hour
+)
+
+// CreateTranslationRequestResult represents the result of creating translation request.
+// Created is false when an existing request was returned: Replayed is set for request created before
+// with the same idempotency key, Coalesced for identical request in progress.
+type CreateTranslationRequestResult struct {
+	Request   *TranslationRequest
+	Created   bool
+	Replayed  bool
+	Coalesced bool
+	// Response is the response to the first request with the idempotency key when Replayed
+	Response *IdempotentResponse
+}
+
+// IdempotentResponse represents response to the first request with an idempotency key, which requests
+// repeating the key get again
+type IdempotentResponse struct {
+	RequestID uuid.UUID `json:"request_id"`
+	Status    int       `json:"status"`
+	Body      []byte    `json:"body"`
+}
+
+// Fingerprint returns hash identifying what request translates: its project, source data and languages
+func (tr *TranslationRequest) Fingerprint() string {
+	languages := append([]string(nil), tr.Languages...)
+	sort.Strings(languages)
+
+	// Map keys are marshalled sorted, so equal requests have equal fingerprints
+	data, _ := json.Marshal(struct {
+		Project    string            `json:"project"`
+		SourceData map[string]string `json:"source_data"`
+		Languages  []string          `json:"languages"`
+	}{tr.Project, tr.SourceData, languages})
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// idempotencyClaim returns claim name of idempotency key of options, keys are scoped to project
+// and to API key or user creating the request
+func idempotencyClaim(options RequestOptions) string {
+	return fmt.Sprintf("idempotency:%s:%s:%s", options.Project, options.CreatedBy, options.IdempotencyKey)
+}
+
+// coalesceClaim returns claim name of requests in progress identical to request
+func coalesceClaim(request *TranslationRequest) string {
+	return fmt.Sprintf("inflight:%s", request.Fingerprint())
+}
+
+// findDuplicateRequest claims idempotency key and fingerprint of new request as options ask, returning
+// the existing request they belong to instead, nil when request is to be created
+func (s *Service) findDuplicateRequest(ctx context.Context, request *TranslationRequest, options RequestOptions) (*CreateTranslationRequestResult, error) {
+	if options.IdempotencyKey != "" {
+		name := idempotencyClaim(options)
+		owner, claimed, err := s.repo.ClaimRequestKey(ctx, name, request.ID, idempotencyKeyTTL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim idempotency key: %w", err)
+		}
+		if !claimed {
+			existing, err := s.repo.GetRequestByID(ctx, owner)
+			if err != nil {
+				// The first request is still being created
+				return nil, fmt.Errorf("request with this idempotency key is in progress")
+			}
+			if existing.Fingerprint() != request.Fingerprint() {
+				return nil, fmt.Errorf("idempotency key was used for a different request")
+			}
+			response, err := s.repo.GetIdempotentResponse(ctx, name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get idempotent response: %w", err)
+			}
+			if response == nil || response.RequestID != existing.ID {
+				// The first request hasn't been responded to yet
+				return nil, fmt.Errorf("request with this idempotency key is in progress")
+			}
+			return &CreateTranslationRequestResult{Request: existing, Replayed: true, Response: response}, nil
+		}
+	}
+
+	if !options.Coalesce {
+		return nil, nil
+	}
+
+	existing, err := s.claimInFlight(ctx, request)
+	if err != nil || existing == nil {
+		if err != nil {
+			s.ReleaseRequestKeys(ctx, request, options)
+		}
+		return nil, err
+	}
+
+	// Replays of the idempotency key return the request this one was coalesced onto
+	if options.IdempotencyKey != "" {
+		name := idempotencyClaim(options)
+		if err := s.repo.ReleaseRequestKey(ctx, name, request.ID); err != nil {
+			return nil, fmt.Errorf("failed to release idempotency key: %w", err)
+		}
+		if _, _, err := s.repo.ClaimRequestKey(ctx, name, existing.ID, idempotencyKeyTTL); err != nil {
+			return nil, fmt.Errorf("failed to claim idempotency key: %w", err)
+		}
+	}
+
+	return &CreateTranslationRequestResult{Request: existing, Coalesced: true}, nil
+}
+
+// SaveIdempotentResponse saves response to request created with idempotency key of options, so requests
+// repeating the key get it again
+func (s *Service) SaveIdempotentResponse(ctx context.Context, options RequestOptions, response *IdempotentResponse) error {
+	if err := s.repo.SaveIdempotentResponse(ctx, idempotencyClaim(options), response, idempotencyKeyTTL); err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+	return nil
+}
+
+// claimInFlight claims fingerprint of request for it, returning identical request in progress holding it
+// instead. Fingerprints held by finished requests are taken over.
+func (s *Service) claimInFlight(ctx context.Context, request *TranslationRequest) (*TranslationRequest, error) {
+	name := coalesceClaim(request)
+	owner, claimed, err := s.repo.ClaimRequestKey(ctx, name, request.ID, coalesceTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim request fingerprint: %w", err)
+	}
+	if claimed {
+		return nil, nil
+	}
+
+	existing, err := s.repo.GetRequestByID(ctx, owner)
+	if err != nil {
+		// Identical request is still being created, this one is not coalesced onto it
+		return nil, nil
+	}
+	if !existing.Status.IsFinal() {
+		return existing, nil
+	}
+
+	if err := s.repo.ReleaseRequestKey(ctx, name, owner); err != nil {
+		return nil, fmt.Errorf("failed to release request fingerprint: %w", err)
+	}
+	owner, claimed, err = s.repo.ClaimRequestKey(ctx, name, request.ID, coalesceTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim request fingerprint: %w", err)
+	}
+	if claimed {
+		return nil, nil
+	}
+
+	// An identical request was created concurrently
+	existing, err = s.repo.GetRequestByID(ctx, owner)
+	if err != nil || existing.Status.IsFinal() {
+		return nil, nil
+	}
+	return existing, nil
+}
+
+// ReleaseRequestKeys releases idempotency key and fingerprint claimed for request that could not be created,
+// so creating it can be retried
+func (s *Service) ReleaseRequestKeys(ctx context.Context, request *TranslationRequest, options RequestOptions) {
+	if options.IdempotencyKey != "" {
+		if err := s.repo.ReleaseRequestKey(ctx, idempotencyClaim(options), request.ID); err != nil {
+			log.Printf("Failed to release idempotency key of request %s: %v", request.ID, err)
+		}
+	}
+	if options.Coalesce {
+		if err := s.repo.ReleaseRequestKey(ctx, coalesceClaim(request), request.ID); err != nil {
+			log.Printf("Failed to release fingerprint of request %s: %v", request.ID, err)
+		}
+	}
+}
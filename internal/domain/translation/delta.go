@@ -0,0 +1,81 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DeltaChange represents the latest state of one key language changed since a sequence
+type DeltaChange struct {
+	Key      string `json:"key"`
+	Language string `json:"language"`
+	Value    string `json:"value,omitempty"`
+	Deleted  bool   `json:"deleted"`
+	Sequence int64  `json:"sequence"`
+}
+
+// Delta represents net changes of source values and translations since a sequence
+type Delta struct {
+	FromSequence int64
+	Sequence     int64
+	Changes      []*DeltaChange
+}
+
+// GetChangesSince gets net changes recorded after sequence, optionally limited to languages.
+// Deleted values are returned as tombstones.
+func (s *Service) GetChangesSince(ctx context.Context, sequence int64, languages []string) (*Delta, error) {
+	entries, err := s.repo.GetChangesSince(ctx, sequence)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get changes: %w", err)
+	}
+
+	wanted := make(map[string]bool, len(languages))
+	for _, lang := range languages {
+		wanted[lang] = true
+	}
+
+	delta := &Delta{
+		FromSequence: sequence,
+		Sequence:     sequence,
+	}
+
+	// Later entries override earlier ones, so only the latest state of each key language is returned
+	latest := make(map[string]*DeltaChange)
+	for _, entry := range entries {
+		if entry.Sequence > delta.Sequence {
+			delta.Sequence = entry.Sequence
+		}
+		if len(wanted) > 0 && !wanted[entry.Language] {
+			continue
+		}
+
+		latest[entry.Key+"\x00"+entry.Language] = &DeltaChange{
+			Key:      entry.Key,
+			Language: entry.Language,
+			Value:    entry.Value,
+			Deleted:  entry.Action == HistoryActionDeleted,
+			Sequence: entry.Sequence,
+		}
+	}
+
+	for _, change := range latest {
+		delta.Changes = append(delta.Changes, change)
+	}
+	sort.Slice(delta.Changes, func(i, j int) bool {
+		return delta.Changes[i].Sequence < delta.Changes[j].Sequence
+	})
+
+	return delta, nil
+}
+
+// GetChangesSinceTime gets net changes recorded after given time, optionally limited to languages
+func (s *Service) GetChangesSinceTime(ctx context.Context, at time.Time, languages []string) (*Delta, error) {
+	sequence, err := s.repo.GetSequenceAt(ctx, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve sequence: %w", err)
+	}
+
+	return s.GetChangesSince(ctx, sequence, languages)
+}
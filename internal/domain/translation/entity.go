@@ -12,11 +12,38 @@ type TranslationRequest struct {
 	Status      RequestStatus     `json:"status"`
 	SourceData  map[string]string `json:"source_data"`
 	Languages   []string          `json:"languages"`
+	Project     string            `json:"project,omitempty"`
+	CallbackURL string            `json:"callback_url,omitempty"`
+	Priority    int               `json:"priority"`
+	Error       string            `json:"error,omitempty"`
+	CreatedBy   string            `json:"created_by,omitempty"`
 	CreatedAt   time.Time         `json:"created_at"`
 	UpdatedAt   time.Time         `json:"updated_at"`
 	CompletedAt *time.Time        `json:"completed_at,omitempty"`
 }
 
+// RequestOptions represents optional settings of translation request
+type RequestOptions struct {
+	// Project the request belongs to, selects webhooks notified about the request
+	Project string
+	// CallbackURL receives webhook payloads of this request only
+	CallbackURL string
+	// Priority of request from 0 to MaxPriority, DefaultPriority when nil
+	Priority *int
+	// IdempotencyKey makes repeated creation with the same key return the request created first
+	IdempotencyKey string
+	// Coalesce returns identical request in progress instead of creating a new one
+	Coalesce bool
+	// CreatedBy identifies API key that created the request
+	CreatedBy string
+}
+
+// Request priorities, higher priority requests are processed first
+const (
+	MaxPriority     = 9
+	DefaultPriority = 5
+)
+
 // TranslationKey represents translation key
 type TranslationKey struct {
 	Key          string            `json:"key"`
@@ -33,17 +60,34 @@ const (
 	StatusCompleted  RequestStatus = "completed"
 	StatusFailed     RequestStatus = "failed"
 	StatusCancelled  RequestStatus = "cancelled"
+
+	// StatusPartiallyCompleted means processing finished but some keys failed to translate
+	StatusPartiallyCompleted RequestStatus = "partially_completed"
 )
 
+// IsFinal reports whether request in this status will not be processed anymore
+func (s RequestStatus) IsFinal() bool {
+	return s == StatusCompleted || s == StatusPartiallyCompleted || s == StatusFailed || s == StatusCancelled
+}
+
 // NewTranslationRequest creates a new translation request
-func NewTranslationRequest(sourceData map[string]string, languages []string) *TranslationRequest {
+func NewTranslationRequest(sourceData map[string]string, languages []string, options RequestOptions) *TranslationRequest {
+	priority := DefaultPriority
+	if options.Priority != nil {
+		priority = *options.Priority
+	}
+
 	return &TranslationRequest{
-		ID:         uuid.New(),
-		Status:     StatusPending,
-		SourceData: sourceData,
-		Languages:  languages,
-		CreatedAt:  time.Now(),
-		UpdatedAt:  time.Now(),
+		ID:          uuid.New(),
+		Status:      StatusPending,
+		SourceData:  sourceData,
+		Languages:   languages,
+		Project:     options.Project,
+		CallbackURL: options.CallbackURL,
+		Priority:    priority,
+		CreatedBy:   options.CreatedBy,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
 	}
 }
 
@@ -61,12 +105,34 @@ func (tr *TranslationRequest) MarkAsCompleted() {
 	tr.CompletedAt = &now
 }
 
+// MarkAsPartiallyCompleted marks request as completed with some keys failed
+func (tr *TranslationRequest) MarkAsPartiallyCompleted() {
+	tr.Status = StatusPartiallyCompleted
+	tr.UpdatedAt = time.Now()
+	now := time.Now()
+	tr.CompletedAt = &now
+}
+
 // MarkAsFailed marks request as failed
 func (tr *TranslationRequest) MarkAsFailed() {
 	tr.Status = StatusFailed
 	tr.UpdatedAt = time.Now()
 }
 
+// MarkAsFailedWithError marks request as failed because of error that stopped its processing
+func (tr *TranslationRequest) MarkAsFailedWithError(err string) {
+	tr.MarkAsFailed()
+	tr.Error = err
+}
+
+// Reopen returns request to pending status, so it is processed again
+func (tr *TranslationRequest) Reopen() {
+	tr.Status = StatusPending
+	tr.Error = ""
+	tr.CompletedAt = nil
+	tr.UpdatedAt = time.Now()
+}
+
 // MarkAsCancelled marks request as cancelled
 func (tr *TranslationRequest) MarkAsCancelled() {
 	tr.Status = StatusCancelled
@@ -0,0 +1,48 @@
+package translation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType represents type of request progress event
+type EventType string
+
+const (
+	// EventTypeStatus is emitted on request status transitions
+	EventTypeStatus EventType = "status"
+	// EventTypeKey is emitted when a key is translated, reused from cache or failed in one language
+	EventTypeKey EventType = "key"
+)
+
+// RequestEvent represents progress event of a translation request
+type RequestEvent struct {
+	Type      EventType     `json:"type"`
+	RequestID uuid.UUID     `json:"request_id"`
+	Status    RequestStatus `json:"status,omitempty"`
+	Result    *KeyResult    `json:"result,omitempty"`
+	Value     string        `json:"value,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// NewStatusEvent creates status transition event
+func NewStatusEvent(requestID uuid.UUID, status RequestStatus) *RequestEvent {
+	return &RequestEvent{
+		Type:      EventTypeStatus,
+		RequestID: requestID,
+		Status:    status,
+		CreatedAt: time.Now(),
+	}
+}
+
+// NewKeyEvent creates key outcome event, value is the translation when key was translated or reused
+func NewKeyEvent(requestID uuid.UUID, result *KeyResult, value string) *RequestEvent {
+	return &RequestEvent{
+		Type:      EventTypeKey,
+		RequestID: requestID,
+		Result:    result,
+		Value:     value,
+		CreatedAt: time.Now(),
+	}
+}
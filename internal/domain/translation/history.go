@@ -0,0 +1,156 @@
+package translation
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SourceLanguage is the history language used for changes of key source value
+const SourceLanguage = "source"
+
+// HistoryAction represents type of change recorded in history
+type HistoryAction string
+
+const (
+	HistoryActionCreated HistoryAction = "created"
+	HistoryActionUpdated HistoryAction = "updated"
+	HistoryActionDeleted HistoryAction = "deleted"
+)
+
+// ChangeSource describes who or what produced a change
+type ChangeSource struct {
+	Provider  string     `json:"provider,omitempty"`
+	Model     string     `json:"model,omitempty"`
+	RequestID *uuid.UUID `json:"request_id,omitempty"`
+	User      string     `json:"user,omitempty"`
+}
+
+// HistoryEntry represents versioned change of key source value or one of its translations
+type HistoryEntry struct {
+	Key           string        `json:"key"`
+	Language      string        `json:"language"`
+	Version       int64         `json:"version"`
+	Sequence      int64         `json:"sequence,omitempty"`
+	Action        HistoryAction `json:"action"`
+	Value         string        `json:"value,omitempty"`
+	PreviousValue string        `json:"previous_value,omitempty"`
+	Source        ChangeSource  `json:"source"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+// RollbackResult represents the result of rolling translations back
+type RollbackResult struct {
+	Changes []*HistoryEntry
+}
+
+type changeSourceKey struct{}
+
+// WithChangeSource returns context carrying source of changes made with it
+func WithChangeSource(ctx context.Context, source ChangeSource) context.Context {
+	return context.WithValue(ctx, changeSourceKey{}, source)
+}
+
+// ChangeSourceFromContext returns source of changes carried by context
+func ChangeSourceFromContext(ctx context.Context) ChangeSource {
+	source, _ := ctx.Value(changeSourceKey{}).(ChangeSource)
+	return source
+}
+
+// WithChangeProvider returns context attributing changes to provider and model, keeping the rest of the source
+func WithChangeProvider(ctx context.Context, provider, model string) context.Context {
+	source := ChangeSourceFromContext(ctx)
+	source.Provider = provider
+	source.Model = model
+	return WithChangeSource(ctx, source)
+}
+
+// WithChangeRequestID returns context attributing changes to translation request, keeping the rest of the source
+func WithChangeRequestID(ctx context.Context, requestID uuid.UUID) context.Context {
+	source := ChangeSourceFromContext(ctx)
+	source.RequestID = &requestID
+	return WithChangeSource(ctx, source)
+}
+
+// WithChangeUser returns context attributing changes to API key or user, keeping the rest of the source
+func WithChangeUser(ctx context.Context, user string) context.Context {
+	source := ChangeSourceFromContext(ctx)
+	source.User = user
+	return WithChangeSource(ctx, source)
+}
+
+// diffKeys builds history entries describing transition of key from before to after.
+// Nil before means the key is created, nil after means it is deleted.
+func diffKeys(before, after *TranslationKey, source ChangeSource) []*HistoryEntry {
+	oldFields := keyFields(before)
+	newFields := keyFields(after)
+
+	languages := make([]string, 0, len(oldFields)+len(newFields))
+	for lang := range oldFields {
+		languages = append(languages, lang)
+	}
+	for lang := range newFields {
+		if _, exists := oldFields[lang]; !exists {
+			languages = append(languages, lang)
+		}
+	}
+	sort.Slice(languages, func(i, j int) bool {
+		// Source value always goes first so replaying history creates the key before its translations
+		if languages[i] == SourceLanguage || languages[j] == SourceLanguage {
+			return languages[i] == SourceLanguage
+		}
+		return languages[i] < languages[j]
+	})
+
+	now := time.Now()
+	var entries []*HistoryEntry
+	for _, lang := range languages {
+		oldValue, hadOld := oldFields[lang]
+		newValue, hasNew := newFields[lang]
+
+		entry := &HistoryEntry{
+			Language:      lang,
+			Value:         newValue,
+			PreviousValue: oldValue,
+			Source:        source,
+			CreatedAt:     now,
+		}
+
+		switch {
+		case !hadOld:
+			entry.Action = HistoryActionCreated
+		case !hasNew:
+			entry.Action = HistoryActionDeleted
+		case oldValue != newValue:
+			entry.Action = HistoryActionUpdated
+		default:
+			continue
+		}
+
+		if after != nil {
+			entry.Key = after.Key
+		} else {
+			entry.Key = before.Key
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// keyFields flattens key into map of history language to value
+func keyFields(key *TranslationKey) map[string]string {
+	fields := make(map[string]string)
+	if key == nil {
+		return fields
+	}
+
+	fields[SourceLanguage] = key.Value
+	for lang, value := range key.Translations {
+		fields[lang] = value
+	}
+
+	return fields
+}
@@ -0,0 +1,217 @@
+package translation
+
+import (
+	"slices"
+)
+
+// Action represents operation subject to authorization
+type Action string
+
+const (
+	// ActionReadRequests allows reading, listing and streaming translation requests
+	ActionReadRequests Action = "requests:read"
+	// ActionCreateRequests allows creating translation requests, including synchronous translation
+	ActionCreateRequests Action = "requests:create"
+	// ActionCancelRequests allows cancelling translation requests
+	ActionCancelRequests Action = "requests:cancel"
+	// ActionRetryRequests allows retrying finished translation requests
+	ActionRetryRequests Action = "requests:retry"
+	// ActionManageWebhooks allows managing webhooks and their deliveries
+	ActionManageWebhooks Action = "webhooks:manage"
+	// ActionReadTranslations allows reading stored translations, their history and changes
+	ActionReadTranslations Action = "translations:read"
+	// ActionWriteTranslations allows caching translations and rolling them back
+	ActionWriteTranslations Action = "translations:write"
+	// ActionDeleteTranslations allows deleting translation keys
+	ActionDeleteTranslations Action = "translations:delete"
+	// ActionReadReleases allows reading releases and their bundles
+	ActionReadReleases Action = "releases:read"
+	// ActionCreateReleases allows creating releases
+	ActionCreateReleases Action = "releases:create"
+	// ActionPublishReleases allows moving release aliases
+	ActionPublishReleases Action = "releases:publish"
+	// ActionManageQueue allows inspecting the task queue and dead-lettered tasks
+	ActionManageQueue Action = "queue:manage"
+	// ActionManageAPIKeys allows managing API keys
+	ActionManageAPIKeys Action = "api_keys:manage"
+	// ActionReadAuditLog allows listing and exporting the audit log
+	ActionReadAuditLog Action = "audit:read"
+)
+
+// ActionScope represents what part of data an action applies to
+type ActionScope int
+
+const (
+	// ScopeProject actions apply to requests and webhooks of one project
+	ScopeProject ActionScope = iota
+	// ScopeSharedRead actions read data shared by all projects, granting them in any project is enough
+	ScopeSharedRead
+	// ScopeShared actions change data shared by all projects, they have to be granted in all projects
+	ScopeShared
+)
+
+// actionScopes maps actions not applying to one project to their scope
+var actionScopes = map[Action]ActionScope{
+	ActionReadTranslations:   ScopeSharedRead,
+	ActionReadReleases:       ScopeSharedRead,
+	ActionWriteTranslations:  ScopeShared,
+	ActionDeleteTranslations: ScopeShared,
+	ActionCreateReleases:     ScopeShared,
+	ActionPublishReleases:    ScopeShared,
+	ActionManageQueue:        ScopeShared,
+	ActionManageAPIKeys:      ScopeShared,
+	ActionReadAuditLog:       ScopeShared,
+}
+
+// Scope returns what part of data action applies to
+func (a Action) Scope() ActionScope {
+	return actionScopes[a]
+}
+
+// Role represents set of actions granted to a user in a project or in all projects
+type Role string
+
+const (
+	// RoleViewer reads requests, translations and releases
+	RoleViewer Role = "viewer"
+	// RoleTranslator also creates, cancels and retries translation requests
+	RoleTranslator Role = "translator"
+	// RoleReviewer also corrects stored translations and rolls them back
+	RoleReviewer Role = "reviewer"
+	// RoleDeveloper also deletes translation keys, manages releases and webhooks
+	RoleDeveloper Role = "developer"
+	// RoleAdmin is allowed everything
+	RoleAdmin Role = "admin"
+)
+
+// Roles lists all roles from least to most privileged
+var Roles = []Role{RoleViewer, RoleTranslator, RoleReviewer, RoleDeveloper, RoleAdmin}
+
+var (
+	viewerActions     = []Action{ActionReadRequests, ActionReadTranslations, ActionReadReleases}
+	translatorActions = append(slices.Clone(viewerActions), ActionCreateRequests, ActionCancelRequests, ActionRetryRequests)
+	reviewerActions   = append(slices.Clone(translatorActions), ActionWriteTranslations)
+	developerActions  = append(slices.Clone(reviewerActions), ActionDeleteTranslations, ActionCreateReleases, ActionPublishReleases, ActionManageWebhooks)
+	allActions        = append(slices.Clone(developerActions), ActionManageQueue, ActionManageAPIKeys, ActionReadAuditLog)
+)
+
+// roleActions maps roles to actions they grant
+var roleActions = map[Role][]Action{
+	RoleViewer:     viewerActions,
+	RoleTranslator: translatorActions,
+	RoleReviewer:   reviewerActions,
+	RoleDeveloper:  developerActions,
+	RoleAdmin:      allActions,
+}
+
+// permissionActions maps API key permissions to actions they grant
+var permissionActions = map[Permission][]Action{
+	PermissionRead:       viewerActions,
+	PermissionTranslate:  {ActionCreateRequests, ActionCancelRequests, ActionRetryRequests},
+	PermissionCacheWrite: {ActionWriteTranslations, ActionDeleteTranslations, ActionCreateReleases, ActionPublishReleases},
+	PermissionAdmin:      allActions,
+}
+
+// grantedActions returns actions granted by roles, unknown roles grant nothing
+func grantedActions[T comparable](grants map[T][]Action, roles []T) []Action {
+	var actions []Action
+	for _, role := range roles {
+		for _, action := range grants[role] {
+			if !slices.Contains(actions, action) {
+				actions = append(actions, action)
+			}
+		}
+	}
+	return actions
+}
+
+// projectActions returns actions that can be granted within one project, the rest change shared data
+func projectActions(actions []Action) []Action {
+	var granted []Action
+	for _, action := range actions {
+		if action.Scope() != ScopeShared {
+			granted = append(granted, action)
+		}
+	}
+	return granted
+}
+
+// Can reports whether principal may perform action in project. Project is ignored for actions on data
+// shared by all projects: reading it needs the action in any project, changing it in all projects.
+func (p *Principal) Can(action Action, project string) bool {
+	switch action.Scope() {
+	case ScopeShared:
+		return len(p.Projects) == 0 && slices.Contains(p.Actions, action)
+	case ScopeSharedRead:
+		return p.CanAnywhere(action)
+	}
+
+	if !p.CanAccessProject(project) {
+		return false
+	}
+	return slices.Contains(p.Actions, action) || slices.Contains(p.ProjectActions[project], action)
+}
+
+// CanAnywhere reports whether principal may perform action in at least one project
+func (p *Principal) CanAnywhere(action Action) bool {
+	if action.Scope() == ScopeShared {
+		return p.Can(action, "")
+	}
+	if slices.Contains(p.Actions, action) {
+		return true
+	}
+	for project, actions := range p.ProjectActions {
+		if slices.Contains(actions, action) && p.CanAccessProject(project) {
+			return true
+		}
+	}
+	return false
+}
+
+// ProjectsWhere returns projects principal may perform action in, nil when it may in all projects
+func (p *Principal) ProjectsWhere(action Action) []string {
+	if slices.Contains(p.Actions, action) {
+		return p.Projects
+	}
+
+	projects := []string{}
+	for project, actions := range p.ProjectActions {
+		if slices.Contains(actions, action) && p.CanAccessProject(project) {
+			projects = append(projects, project)
+		}
+	}
+	slices.Sort(projects)
+	return projects
+}
+
+// HasRoleIn reports whether principal was granted anything in project. Resources of other projects
+// don't exist for it.
+func (p *Principal) HasRoleIn(project string) bool {
+	return p.CanAccessProject(project) && (len(p.Actions) > 0 || len(p.ProjectActions[project]) > 0)
+}
+
+// Covers reports whether principal may perform all actions key grants in all its projects, so it may
+// manage the key
+func (p *Principal) Covers(key *APIKey) bool {
+	actions := grantedActions(permissionActions, key.Permissions)
+	if len(key.Projects) == 0 {
+		if len(p.Projects) > 0 {
+			return false
+		}
+		for _, action := range actions {
+			if !slices.Contains(p.Actions, action) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, project := range key.Projects {
+		for _, action := range actions {
+			if !p.Can(action, project) {
+				return false
+			}
+		}
+	}
+	return true
+}
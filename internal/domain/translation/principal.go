@@ -0,0 +1,24 @@
+package translation
+
+import (
+	"slices"
+)
+
+// Principal represents who a request is made by, an API key or a user, with the actions it may perform.
+// Decisions are made by the policy.
+type Principal struct {
+	// ID is recorded as creator of requests: API key ID, or "user:" followed by token subject
+	ID   string
+	Name string
+	// Actions are granted in every project principal can access
+	Actions []Action
+	// Projects limits principal to projects, all projects when empty
+	Projects []string
+	// ProjectActions are granted only within a project, they never include actions on shared data
+	ProjectActions map[string][]Action
+}
+
+// CanAccessProject reports whether project is within projects principal is limited to
+func (p *Principal) CanAccessProject(project string) bool {
+	return len(p.Projects) == 0 || slices.Contains(p.Projects, project)
+}
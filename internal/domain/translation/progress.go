@@ -0,0 +1,138 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// KeyOutcome represents outcome of translating one key to one language within a request
+type KeyOutcome string
+
+const (
+	KeyOutcomeTranslated KeyOutcome = "translated"
+	KeyOutcomeCached     KeyOutcome = "cached"
+	KeyOutcomeFailed     KeyOutcome = "failed"
+	KeyOutcomeDiscarded  KeyOutcome = "discarded"
+)
+
+// KeyResult represents outcome of translating one key to one language within a request
+type KeyResult struct {
+	Key       string     `json:"key"`
+	Language  string     `json:"language"`
+	Outcome   KeyOutcome `json:"outcome"`
+	Error     string     `json:"error,omitempty"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// LanguageProgress represents translation progress of a request in one language.
+// Done counts translated keys, Skipped counts keys reused from cache, Discarded counts translations
+// removed when request was cancelled.
+type LanguageProgress struct {
+	Total     int `json:"total"`
+	Done      int `json:"done"`
+	Failed    int `json:"failed"`
+	Skipped   int `json:"skipped"`
+	Discarded int `json:"discarded"`
+}
+
+// Pending returns number of keys without outcome yet
+func (p *LanguageProgress) Pending() int {
+	return p.Total - p.Done - p.Failed - p.Skipped - p.Discarded
+}
+
+// add counts outcome
+func (p *LanguageProgress) add(outcome KeyOutcome) {
+	switch outcome {
+	case KeyOutcomeTranslated:
+		p.Done++
+	case KeyOutcomeCached:
+		p.Skipped++
+	case KeyOutcomeFailed:
+		p.Failed++
+	case KeyOutcomeDiscarded:
+		p.Discarded++
+	}
+}
+
+// RequestProgress represents translation progress of a request overall and per language
+type RequestProgress struct {
+	LanguageProgress
+	Languages map[string]*LanguageProgress `json:"languages"`
+	Results   []*KeyResult                 `json:"results"`
+}
+
+// NewKeyResult creates outcome record of one key language, err is recorded as failure reason
+func NewKeyResult(key string, language string, outcome KeyOutcome, err error) *KeyResult {
+	result := &KeyResult{
+		Key:       key,
+		Language:  language,
+		Outcome:   outcome,
+		UpdatedAt: time.Now(),
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// RecordKeyResults records outcomes of request keys, replacing earlier outcomes of the same key languages
+func (s *Service) RecordKeyResults(ctx context.Context, requestID uuid.UUID, results []*KeyResult) error {
+	if len(results) == 0 {
+		return nil
+	}
+
+	if err := s.repo.SaveKeyResults(ctx, requestID, results); err != nil {
+		return fmt.Errorf("failed to save key results: %w", err)
+	}
+
+	return nil
+}
+
+// GetRequestProgress counts recorded key outcomes of request against its keys and languages
+func (s *Service) GetRequestProgress(ctx context.Context, request *TranslationRequest) (*RequestProgress, error) {
+	results, err := s.repo.GetKeyResults(ctx, request.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key results: %w", err)
+	}
+
+	keyCount := 0
+	for key := range request.SourceData {
+		// Keys starting with @ are metadata and never translated
+		if !strings.HasPrefix(key, "@") {
+			keyCount++
+		}
+	}
+
+	progress := &RequestProgress{
+		Languages: make(map[string]*LanguageProgress, len(request.Languages)),
+		Results:   results,
+	}
+	for _, lang := range request.Languages {
+		progress.Languages[lang] = &LanguageProgress{Total: keyCount}
+		progress.Total += keyCount
+	}
+
+	for _, result := range results {
+		languageProgress, exists := progress.Languages[result.Language]
+		if !exists {
+			continue
+		}
+		languageProgress.add(result.Outcome)
+		progress.add(result.Outcome)
+	}
+
+	sort.Slice(progress.Results, func(i, j int) bool {
+		if progress.Results[i].Language != progress.Results[j].Language {
+			return progress.Results[i].Language < progress.Results[j].Language
+		}
+		return progress.Results[i].Key < progress.Results[j].Key
+	})
+
+	return progress, nil
+}
@@ -0,0 +1,86 @@
+package translation
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// QuotaName identifies a rate limit or quota
+type QuotaName string
+
+const (
+	// QuotaRequestsPerMinute limits API requests of one API key or user
+	QuotaRequestsPerMinute QuotaName = "requests_per_minute"
+	// QuotaKeysPerDay limits translations (keys times languages) requested for one project
+	QuotaKeysPerDay QuotaName = "keys_per_day"
+	// QuotaCharactersPerMonth limits characters of source values times languages requested for one project
+	QuotaCharactersPerMonth QuotaName = "characters_per_month"
+)
+
+// Window returns start and end of the UTC window of quota containing t
+func (n QuotaName) Window(t time.Time) (time.Time, time.Time) {
+	t = t.UTC()
+	switch n {
+	case QuotaKeysPerDay:
+		start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 0, 1)
+	case QuotaCharactersPerMonth:
+		start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0)
+	default:
+		start := t.Truncate(time.Minute)
+		return start, start.Add(time.Minute)
+	}
+}
+
+// Usage represents consumption of a rate limit or quota in its current window
+type Usage struct {
+	Name QuotaName
+	// Limit is maximum usage in the window, 0 means unlimited
+	Limit int64
+	Used  int64
+	// ResetAt is when the window ends
+	ResetAt time.Time
+}
+
+// Remaining returns usage left in the window, never negative
+func (u *Usage) Remaining() int64 {
+	if u.Used >= u.Limit {
+		return 0
+	}
+	return u.Limit - u.Used
+}
+
+// UsageIncrement represents amount added to usage counter unless that exceeds its limit
+type UsageIncrement struct {
+	Key    string
+	Amount int64
+	// Limit is maximum value of the counter, 0 means unlimited
+	Limit int64
+	// ExpiresAt is when the counter window ends
+	ExpiresAt time.Time
+}
+
+// RequestUsage returns number of translations (keys times languages) and characters translated for request
+// of source data, metadata keys aren't translated
+func RequestUsage(sourceData map[string]string, languages []string) (int64, int64) {
+	var keys, characters int64
+	for key, value := range sourceData {
+		if !strings.HasPrefix(key, "@") {
+			keys++
+			characters += int64(utf8.RuneCountInString(value))
+		}
+	}
+	return keys * int64(len(languages)), characters * int64(len(languages))
+}
+
+// KeyLanguagesUsage returns number of translations and characters translated for key languages of request
+// of source data
+func KeyLanguagesUsage(sourceData map[string]string, keyLanguages []*KeyResult) (int64, int64) {
+	var characters int64
+	for _, keyLanguage := range keyLanguages {
+		characters += int64(utf8.RuneCountInString(sourceData[keyLanguage.Key]))
+	}
+	return int64(len(keyLanguages)), characters
+}
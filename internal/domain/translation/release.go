@@ -0,0 +1,259 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+)
+
+// SourceLocale is the locale of key source values
+const SourceLocale = "en"
+
+// LatestRelease is the alias resolving to the most recently published release
+const LatestRelease = "latest"
+
+// releaseNamePattern restricts release names to version-like identifiers, e.g. v2.3.0
+var releaseNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+
+// Release represents immutable named snapshot of source values and translations
+type Release struct {
+	Name         string                       `json:"name"`
+	Description  string                       `json:"description,omitempty"`
+	SourceData   map[string]string            `json:"source_data"`
+	Translations map[string]map[string]string `json:"translations"`
+	CreatedAt    time.Time                    `json:"created_at"`
+	PublishedAt  *time.Time                   `json:"published_at,omitempty"`
+}
+
+// Languages returns sorted list of languages contained in release
+func (r *Release) Languages() []string {
+	languages := make([]string, 0, len(r.Translations))
+	for lang := range r.Translations {
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+
+	return languages
+}
+
+// Locales returns sorted list of locales that can be delivered from release, including source locale
+func (r *Release) Locales() []string {
+	locales := r.Languages()
+	if _, exists := r.Translations[SourceLocale]; !exists && len(r.SourceData) > 0 {
+		locales = append(locales, SourceLocale)
+		sort.Strings(locales)
+	}
+
+	return locales
+}
+
+// Bundle returns key-value bundle of locale, source values serve as the source locale bundle
+func (r *Release) Bundle(locale string) (map[string]string, bool) {
+	if locale == SourceLocale {
+		return r.SourceData, len(r.SourceData) > 0
+	}
+
+	bundle, exists := r.Translations[locale]
+	return bundle, exists
+}
+
+// ValueChange represents changed value of a key
+type ValueChange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// LanguageDiff represents differences of one language between two releases
+type LanguageDiff struct {
+	Added   map[string]string      `json:"added"`
+	Removed map[string]string      `json:"removed"`
+	Changed map[string]ValueChange `json:"changed"`
+}
+
+// ReleaseDiff represents differences between two releases per language ("source" for source values)
+type ReleaseDiff struct {
+	From      string                   `json:"from"`
+	To        string                   `json:"to"`
+	Languages map[string]*LanguageDiff `json:"languages"`
+}
+
+// CreateRelease freezes current source values and translations into a named release
+func (s *Service) CreateRelease(ctx context.Context, name string, description string) (*Release, error) {
+	if !releaseNamePattern.MatchString(name) || name == LatestRelease {
+		return nil, fmt.Errorf("invalid release name")
+	}
+
+	keys, err := s.repo.GetAllTranslationKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all translation keys: %w", err)
+	}
+
+	release := &Release{
+		Name:         name,
+		Description:  description,
+		SourceData:   make(map[string]string, len(keys)),
+		Translations: make(map[string]map[string]string),
+		CreatedAt:    time.Now(),
+	}
+
+	for _, key := range keys {
+		release.SourceData[key.Key] = key.Value
+		for lang, value := range key.Translations {
+			if release.Translations[lang] == nil {
+				release.Translations[lang] = make(map[string]string)
+			}
+			release.Translations[lang][key.Key] = value
+		}
+	}
+
+	if err := s.repo.SaveRelease(ctx, release); err != nil {
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// GetRelease gets release by name
+func (s *Service) GetRelease(ctx context.Context, name string) (*Release, error) {
+	return s.repo.GetRelease(ctx, name)
+}
+
+// GetAllReleases gets all releases ordered by creation time
+func (s *Service) GetAllReleases(ctx context.Context) ([]*Release, error) {
+	releases, err := s.repo.GetAllReleases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get releases: %w", err)
+	}
+
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].CreatedAt.Before(releases[j].CreatedAt)
+	})
+
+	return releases, nil
+}
+
+// PublishRelease makes release available for over-the-air delivery
+func (s *Service) PublishRelease(ctx context.Context, name string) (*Release, error) {
+	release, err := s.repo.GetRelease(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if release.PublishedAt != nil {
+		return release, nil
+	}
+
+	now := time.Now()
+	if err := s.repo.PublishRelease(ctx, name, now); err != nil {
+		return nil, fmt.Errorf("failed to publish release: %w", err)
+	}
+
+	release.PublishedAt = &now
+	return release, nil
+}
+
+// GetPublishedRelease gets published release by name, "latest" resolves to the most recently published one
+func (s *Service) GetPublishedRelease(ctx context.Context, name string) (*Release, error) {
+	if name != LatestRelease {
+		release, err := s.repo.GetRelease(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if release.PublishedAt == nil {
+			return nil, fmt.Errorf("release not found")
+		}
+		return release, nil
+	}
+
+	releases, err := s.repo.GetAllReleases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get releases: %w", err)
+	}
+
+	var latest *Release
+	for _, release := range releases {
+		if release.PublishedAt == nil {
+			continue
+		}
+		if latest == nil || release.PublishedAt.After(*latest.PublishedAt) {
+			latest = release
+		}
+	}
+
+	if latest == nil {
+		return nil, fmt.Errorf("release not found")
+	}
+
+	return latest, nil
+}
+
+// DiffReleases compares two releases and returns added, removed and changed keys per language
+func (s *Service) DiffReleases(ctx context.Context, fromName string, toName string) (*ReleaseDiff, error) {
+	from, err := s.repo.GetRelease(ctx, fromName)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.repo.GetRelease(ctx, toName)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &ReleaseDiff{
+		From:      from.Name,
+		To:        to.Name,
+		Languages: make(map[string]*LanguageDiff),
+	}
+
+	if languageDiff := diffValues(from.SourceData, to.SourceData); languageDiff != nil {
+		diff.Languages[SourceLanguage] = languageDiff
+	}
+
+	languages := make(map[string]bool)
+	for lang := range from.Translations {
+		languages[lang] = true
+	}
+	for lang := range to.Translations {
+		languages[lang] = true
+	}
+
+	for lang := range languages {
+		if languageDiff := diffValues(from.Translations[lang], to.Translations[lang]); languageDiff != nil {
+			diff.Languages[lang] = languageDiff
+		}
+	}
+
+	return diff, nil
+}
+
+// diffValues compares two key-value maps, returns nil when they are equal
+func diffValues(from, to map[string]string) *LanguageDiff {
+	diff := &LanguageDiff{
+		Added:   make(map[string]string),
+		Removed: make(map[string]string),
+		Changed: make(map[string]ValueChange),
+	}
+
+	for key, oldValue := range from {
+		newValue, exists := to[key]
+		if !exists {
+			diff.Removed[key] = oldValue
+		} else if newValue != oldValue {
+			diff.Changed[key] = ValueChange{From: oldValue, To: newValue}
+		}
+	}
+
+	for key, newValue := range to {
+		if _, exists := from[key]; !exists {
+			diff.Added[key] = newValue
+		}
+	}
+
+	if len(diff.Added) == 0 && len(diff.Removed) == 0 && len(diff.Changed) == 0 {
+		return nil
+	}
+
+	return diff
+}
@@ -2,6 +2,7 @@ package translation
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -14,9 +15,30 @@ type Repository interface {
 	// Get request by ID
 	GetRequestByID(ctx context.Context, id uuid.UUID) (*TranslationRequest, error)
 
+	// Get IDs of stored requests created between from and to ordered by creation time,
+	// zero times leave the range open
+	GetRequestIDs(ctx context.Context, from time.Time, to time.Time) ([]uuid.UUID, error)
+
+	// Delete request with its key outcomes and chunk plan
+	DeleteRequest(ctx context.Context, id uuid.UUID) error
+
+	// Save summary of archived request
+	SaveRequestSummary(ctx context.Context, summary *RequestSummary) error
+
+	// Get summaries of archived requests created between from and to ordered by creation time,
+	// zero times leave the range open
+	GetRequestSummaries(ctx context.Context, from time.Time, to time.Time) ([]*RequestSummary, error)
+
+	// Delete summaries of archived requests created before given time, returning number of deleted summaries
+	DeleteRequestSummariesBefore(ctx context.Context, before time.Time) (int, error)
+
 	// Update request status
 	UpdateRequestStatus(ctx context.Context, id uuid.UUID, status RequestStatus) error
 
+	// Apply finish to request unless it already finished, reporting whether it was applied.
+	// Changes of the request made meanwhile are never overwritten.
+	FinishRequest(ctx context.Context, id uuid.UUID, finish func(*TranslationRequest)) (bool, error)
+
 	// Save translation key
 	SaveTranslationKey(ctx context.Context, key *TranslationKey) error
 
@@ -29,6 +51,13 @@ type Repository interface {
 	// Check key existence
 	KeyExists(ctx context.Context, key string) (bool, error)
 
+	// Set translation of key to language without touching its other translations,
+	// returning previous translation and whether it existed
+	SetKeyTranslation(ctx context.Context, key string, language string, value string) (string, bool, error)
+
+	// Remove translation of key to language if it still has given value, reporting whether it was removed
+	RemoveKeyTranslation(ctx context.Context, key string, language string, value string) (bool, error)
+
 	// Update translation key value and clear translations
 	UpdateTranslationKeyValue(ctx context.Context, key string, newValue string) error
 
@@ -37,4 +66,139 @@ type Repository interface {
 
 	// Get all incomplete requests (pending, processing)
 	GetIncompleteRequests(ctx context.Context) ([]*TranslationRequest, error)
+
+	// Save outcomes of request keys, replacing earlier outcomes of the same key languages
+	SaveKeyResults(ctx context.Context, requestID uuid.UUID, results []*KeyResult) error
+
+	// Get outcomes of request keys
+	GetKeyResults(ctx context.Context, requestID uuid.UUID) ([]*KeyResult, error)
+
+	// Delete outcomes of request keys of the same key languages as given results
+	DeleteKeyResults(ctx context.Context, requestID uuid.UUID, results []*KeyResult) error
+
+	// Replace chunk plan of request with plan of count chunks
+	SaveChunkPlan(ctx context.Context, requestID uuid.UUID, planID uuid.UUID, count int) error
+
+	// Mark chunk of plan as done, true only for the call that finished the last chunk of the current plan
+	CompleteChunk(ctx context.Context, requestID uuid.UUID, planID uuid.UUID, chunk int) (bool, error)
+
+	// Get ID of current chunk plan of request, nil when request has no plan
+	GetChunkPlan(ctx context.Context, requestID uuid.UUID) (*uuid.UUID, error)
+
+	// Add chunks to backlog of project, ordered by priority and then by the time they were added
+	ScheduleChunks(ctx context.Context, project string, chunks []*ScheduledChunk) error
+
+	// Move chunks from project backlog to in flight until limit chunks are in flight, leasing them until
+	// given time. Chunks with expired leases don't count. Each chunk is released to one caller only.
+	ReleaseChunks(ctx context.Context, project string, limit int, leaseUntil time.Time) ([]*ScheduledChunk, error)
+
+	// Remove chunk from chunks in flight of project
+	FinishChunk(ctx context.Context, project string, chunkID string) error
+
+	// Get projects with chunks in backlog
+	GetScheduledProjects(ctx context.Context) ([]string, error)
+
+	// Get number of chunks of request in project backlogs
+	CountScheduledChunks(ctx context.Context, requestID uuid.UUID) (int, error)
+
+	// Extend lease of processing request to given time, leases are never shortened
+	ExtendRequestLease(ctx context.Context, requestID uuid.UUID, until time.Time) error
+
+	// Get lease expiry of request, nil when request has no lease
+	GetRequestLease(ctx context.Context, requestID uuid.UUID) (*time.Time, error)
+
+	// Get requests whose lease expired at given time
+	GetExpiredRequestLeases(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+
+	// Remove lease of request
+	RemoveRequestLease(ctx context.Context, requestID uuid.UUID) error
+
+	// Claim name for request until ttl passes unless another request holds it,
+	// returning the request holding the name and whether it was claimed by this call
+	ClaimRequestKey(ctx context.Context, name string, requestID uuid.UUID, ttl time.Duration) (uuid.UUID, bool, error)
+
+	// Release name if it is still held by request
+	ReleaseRequestKey(ctx context.Context, name string, requestID uuid.UUID) error
+
+	// Save response to request holding idempotency claim name until ttl passes
+	SaveIdempotentResponse(ctx context.Context, name string, response *IdempotentResponse, ttl time.Duration) error
+
+	// Get response saved for idempotency claim name, nil when there is none
+	GetIdempotentResponse(ctx context.Context, name string) (*IdempotentResponse, error)
+
+	// Append entries to translation key history, assigning per-key versions and global change sequence
+	AppendHistory(ctx context.Context, entries []*HistoryEntry) error
+
+	// Get history of translation key ordered by version
+	GetKeyHistory(ctx context.Context, key string) ([]*HistoryEntry, error)
+
+	// Get all keys that have history, including deleted ones
+	GetHistoryKeys(ctx context.Context) ([]string, error)
+
+	// Get changes recorded after given sequence ordered by sequence
+	GetChangesSince(ctx context.Context, sequence int64) ([]*HistoryEntry, error)
+
+	// Get sequence of the last change recorded at or before given time
+	GetSequenceAt(ctx context.Context, at time.Time) (int64, error)
+
+	// Save release, failing if release with the same name already exists
+	SaveRelease(ctx context.Context, release *Release) error
+
+	// Get release by name
+	GetRelease(ctx context.Context, name string) (*Release, error)
+
+	// Get all releases
+	GetAllReleases(ctx context.Context) ([]*Release, error)
+
+	// Mark release as published at given time
+	PublishRelease(ctx context.Context, name string, publishedAt time.Time) error
+
+	// Save webhook
+	SaveWebhook(ctx context.Context, webhook *Webhook) error
+
+	// Get webhook by ID
+	GetWebhook(ctx context.Context, id uuid.UUID) (*Webhook, error)
+
+	// Get all webhooks
+	GetWebhooks(ctx context.Context) ([]*Webhook, error)
+
+	// Delete webhook
+	DeleteWebhook(ctx context.Context, id uuid.UUID) error
+
+	// Save webhook delivery, scheduling it at its next attempt time or unscheduling it when there is none
+	SaveDelivery(ctx context.Context, delivery *WebhookDelivery) error
+
+	// Get webhook delivery by ID
+	GetDelivery(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error)
+
+	// Get deliveries of request and/or webhook, nil filters are ignored
+	GetDeliveries(ctx context.Context, requestID *uuid.UUID, webhookID *uuid.UUID) ([]*WebhookDelivery, error)
+
+	// Unschedule and return up to limit deliveries due at given time, each delivery is returned to one caller only
+	ClaimDueDeliveries(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error)
+
+	// Save API key, indexing it by hash of its secret
+	SaveAPIKey(ctx context.Context, key *APIKey) error
+
+	// Get API key by ID
+	GetAPIKey(ctx context.Context, id string) (*APIKey, error)
+
+	// Get API key by hash of its secret
+	GetAPIKeyByHash(ctx context.Context, hash string) (*APIKey, error)
+
+	// Get all API keys
+	GetAPIKeys(ctx context.Context) ([]*APIKey, error)
+
+	// Record use of API key at given time
+	TouchAPIKey(ctx context.Context, id string, at time.Time) error
+
+	// Append entry to audit log, entries are never changed or deleted
+	AppendAuditEntry(ctx context.Context, entry *AuditEntry) error
+
+	// Count audit entries recorded between from and to, zero times are open
+	CountAuditEntries(ctx context.Context, from time.Time, to time.Time) (int, error)
+
+	// Get up to limit audit entries recorded between from and to ordered by time, newest first when reverse is set,
+	// skipping offset entries. Zero times are open.
+	GetAuditEntries(ctx context.Context, from time.Time, to time.Time, reverse bool, offset int, limit int) ([]*AuditEntry, error)
 }
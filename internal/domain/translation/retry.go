@@ -0,0 +1,163 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// RetryOptions selects key languages of request translated again although they have translations.
+// Without keys and languages only failed and missing translations are retried.
+type RetryOptions struct {
+	// ForceKeys are translated again in ForceLanguages, all keys of request when empty
+	ForceKeys []string
+	// ForceLanguages are translated again for ForceKeys, all languages of request when empty
+	ForceLanguages []string
+}
+
+// forced reports whether key language is translated again
+func (o RetryOptions) forced(key string, language string) bool {
+	if len(o.ForceKeys) == 0 && len(o.ForceLanguages) == 0 {
+		return false
+	}
+	return (len(o.ForceKeys) == 0 || slices.Contains(o.ForceKeys, key)) &&
+		(len(o.ForceLanguages) == 0 || slices.Contains(o.ForceLanguages, language))
+}
+
+// RetryResult represents the result of retrying request
+type RetryResult struct {
+	Request *TranslationRequest
+	// Retried is number of key languages that will be translated again
+	Retried int
+}
+
+// RetryPlan represents key languages retrying request translates again
+type RetryPlan struct {
+	Request *TranslationRequest
+	// Retried are key languages translated again, outcomes are not set
+	Retried []*KeyResult
+	// forced are stored translations of retried key languages removed first
+	forced []forcedTranslation
+}
+
+// forcedTranslation represents stored translation that retry removes
+type forcedTranslation struct {
+	key         string
+	language    string
+	translation string
+}
+
+// Usage returns number of translations and characters retrying request charges to quotas of its project
+func (p *RetryPlan) Usage() (int64, int64) {
+	return KeyLanguagesUsage(p.Request.SourceData, p.Retried)
+}
+
+// PlanRetry finds key languages of finished request that retrying it with options translates again: those
+// that failed, whose translation is missing, or that options force. Nothing is changed.
+func (s *Service) PlanRetry(ctx context.Context, requestID uuid.UUID, options RetryOptions) (*RetryPlan, error) {
+	request, err := s.repo.GetRequestByID(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get request: %w", err)
+	}
+
+	if !request.Status.IsFinal() {
+		return nil, fmt.Errorf("request cannot be retried in status: %s", request.Status)
+	}
+
+	// Keys starting with @ are metadata and never translated
+	var keys []string
+	for key := range request.SourceData {
+		if !strings.HasPrefix(key, "@") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range options.ForceKeys {
+		if !slices.Contains(keys, key) {
+			return nil, fmt.Errorf("retried keys and languages must belong to the request")
+		}
+	}
+	for _, language := range options.ForceLanguages {
+		if !slices.Contains(request.Languages, language) {
+			return nil, fmt.Errorf("retried keys and languages must belong to the request")
+		}
+	}
+
+	results, err := s.repo.GetKeyResults(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key results: %w", err)
+	}
+	outcomes := make(map[string]KeyOutcome, len(results))
+	for _, result := range results {
+		outcomes[result.Language+":"+result.Key] = result.Outcome
+	}
+
+	plan := &RetryPlan{Request: request}
+	for _, keyName := range keys {
+		// Deleted keys are stored again when request is processed
+		key, err := s.repo.GetTranslationKey(ctx, keyName)
+		if err != nil {
+			key = &TranslationKey{Key: keyName}
+		}
+
+		for _, language := range request.Languages {
+			translation, exists := key.Translations[language]
+
+			if options.forced(keyName, language) {
+				if exists {
+					plan.forced = append(plan.forced, forcedTranslation{key: keyName, language: language, translation: translation})
+				}
+			} else if exists {
+				outcome := outcomes[language+":"+keyName]
+				if outcome == KeyOutcomeTranslated || outcome == KeyOutcomeCached {
+					continue
+				}
+			}
+
+			plan.Retried = append(plan.Retried, &KeyResult{Key: keyName, Language: language})
+		}
+	}
+
+	if len(plan.Retried) == 0 {
+		return nil, fmt.Errorf("request has nothing to retry")
+	}
+
+	return plan, nil
+}
+
+// RetryTranslationRequest returns finished request to pending, so processing it again translates only
+// key languages that failed, whose translation is missing, or that options force. Forced translations
+// are removed first. Other key languages keep their outcomes and are skipped.
+func (s *Service) RetryTranslationRequest(ctx context.Context, requestID uuid.UUID, options RetryOptions) (*RetryResult, error) {
+	plan, err := s.PlanRetry(ctx, requestID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Translations removed for retry are attributed to the request
+	ctx = WithChangeRequestID(ctx, requestID)
+
+	for _, forced := range plan.forced {
+		if _, err := s.removeKeyTranslation(ctx, forced.key, forced.language, forced.translation); err != nil {
+			return nil, fmt.Errorf("failed to remove translation of key %s: %w", forced.key, err)
+		}
+	}
+
+	// Retried key languages are left out of the checkpoint of request
+	if err := s.repo.DeleteKeyResults(ctx, requestID, plan.Retried); err != nil {
+		return nil, fmt.Errorf("failed to delete key results: %w", err)
+	}
+
+	request := plan.Request
+	request.Reopen()
+	if err := s.repo.SaveRequest(ctx, request); err != nil {
+		return nil, fmt.Errorf("failed to save request: %w", err)
+	}
+
+	return &RetryResult{Request: request, Retried: len(plan.Retried)}, nil
+}
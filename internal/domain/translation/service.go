@@ -3,7 +3,9 @@ package translation
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -20,15 +22,36 @@ func NewService(repo Repository) *Service {
 	}
 }
 
-// CreateTranslationRequest creates a new translation request
-func (s *Service) CreateTranslationRequest(ctx context.Context, sourceData map[string]string, languages []string) (*TranslationRequest, error) {
-	request := NewTranslationRequest(sourceData, languages)
+// CreateTranslationRequest creates a new translation request. Request created before with the same
+// idempotency key, or identical request in progress when coalescing, is returned instead.
+func (s *Service) CreateTranslationRequest(ctx context.Context, sourceData map[string]string, languages []string, options RequestOptions) (*CreateTranslationRequestResult, error) {
+	if options.CallbackURL != "" {
+		if err := ValidateWebhookURL(options.CallbackURL); err != nil {
+			return nil, fmt.Errorf("invalid callback URL")
+		}
+	}
+
+	if options.Priority != nil && (*options.Priority < 0 || *options.Priority > MaxPriority) {
+		return nil, fmt.Errorf("invalid priority")
+	}
+
+	if len(options.IdempotencyKey) > MaxIdempotencyKeyLength {
+		return nil, fmt.Errorf("invalid idempotency key")
+	}
+
+	request := NewTranslationRequest(sourceData, languages, options)
+
+	existing, err := s.findDuplicateRequest(ctx, request, options)
+	if err != nil || existing != nil {
+		return existing, err
+	}
 
 	if err := s.repo.SaveRequest(ctx, request); err != nil {
+		s.ReleaseRequestKeys(ctx, request, options)
 		return nil, fmt.Errorf("failed to save translation request: %w", err)
 	}
 
-	return request, nil
+	return &CreateTranslationRequestResult{Request: request, Created: true}, nil
 }
 
 // GetTranslationRequest gets request by ID
@@ -62,7 +85,7 @@ func (s *Service) ProcessTranslationRequest(ctx context.Context, requestID uuid.
 		existingKey, err := s.repo.GetTranslationKey(ctx, newKey.Key)
 		if err != nil {
 			// Key doesn't exist, save as new
-			if err := s.repo.SaveTranslationKey(ctx, newKey); err != nil {
+			if err := s.SaveTranslationKey(ctx, newKey); err != nil {
 				// Log error but continue processing
 				fmt.Printf("Failed to save new translation key %s: %v\n", newKey.Key, err)
 			}
@@ -73,7 +96,7 @@ func (s *Service) ProcessTranslationRequest(ctx context.Context, requestID uuid.
 		if existingKey.Value != newKey.Value {
 			// Value has changed, update the key and clear existing translations
 			// so they will be regenerated
-			if err := s.repo.UpdateTranslationKeyValue(ctx, newKey.Key, newKey.Value); err != nil {
+			if err := s.UpdateTranslationKeyValue(ctx, newKey.Key, newKey.Value); err != nil {
 				// Log error but continue processing
 				fmt.Printf("Failed to update translation key %s with new value: %v\n", newKey.Key, err)
 			} else {
@@ -179,7 +202,7 @@ func (s *Service) GetPendingTranslationKeysForRequest(ctx context.Context, sourc
 			if existingKey.Value != keyValue {
 				existingKey.Value = keyValue
 				// Save the updated value without triggering translation
-				if err := s.repo.SaveTranslationKey(ctx, existingKey); err != nil {
+				if err := s.SaveTranslationKey(ctx, existingKey); err != nil {
 					fmt.Printf("Failed to update value for key %s: %v\n", keyName, err)
 				}
 			}
@@ -256,7 +279,7 @@ func (s *Service) CancelTranslationRequest(ctx context.Context, requestID uuid.U
 	}
 
 	// Check if request can be cancelled
-	if request.Status == StatusCompleted || request.Status == StatusFailed || request.Status == StatusCancelled {
+	if request.Status.IsFinal() {
 		return fmt.Errorf("request cannot be cancelled in status: %s", request.Status)
 	}
 
@@ -265,16 +288,132 @@ func (s *Service) CancelTranslationRequest(ctx context.Context, requestID uuid.U
 	return s.repo.UpdateRequestStatus(ctx, requestID, request.Status)
 }
 
-// CompleteTranslationRequest marks a translation request as completed
-func (s *Service) CompleteTranslationRequest(ctx context.Context, requestID uuid.UUID) error {
+// DiscardRequestTranslations removes translations cancelled request saved, recording removals in history
+// and outcomes of their keys as discarded. Translations changed since are kept. Returns number of
+// discarded translations.
+func (s *Service) DiscardRequestTranslations(ctx context.Context, requestID uuid.UUID) (int, error) {
+	results, err := s.repo.GetKeyResults(ctx, requestID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get key results: %w", err)
+	}
+
+	var discarded []*KeyResult
+	for _, result := range results {
+		if result.Outcome != KeyOutcomeTranslated {
+			continue
+		}
+
+		removed, err := s.discardKeyTranslation(ctx, requestID, result.Key, result.Language)
+		if err != nil {
+			return len(discarded), fmt.Errorf("failed to discard translation of key %s: %w", result.Key, err)
+		}
+		if removed {
+			discarded = append(discarded, NewKeyResult(result.Key, result.Language, KeyOutcomeDiscarded, nil))
+		}
+	}
+
+	if err := s.RecordKeyResults(ctx, requestID, discarded); err != nil {
+		return len(discarded), err
+	}
+
+	return len(discarded), nil
+}
+
+// discardKeyTranslation removes translation of key to language when its latest change was made by request
+func (s *Service) discardKeyTranslation(ctx context.Context, requestID uuid.UUID, key string, language string) (bool, error) {
+	history, err := s.repo.GetKeyHistory(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("failed to get key history: %w", err)
+	}
+
+	var latest *HistoryEntry
+	for _, entry := range history {
+		if entry.Language == language && (latest == nil || entry.Version > latest.Version) {
+			latest = entry
+		}
+	}
+	if latest == nil || latest.Action != HistoryActionCreated ||
+		latest.Source.RequestID == nil || *latest.Source.RequestID != requestID {
+		return false, nil
+	}
+
+	return s.removeKeyTranslation(ctx, key, language, latest.Value)
+}
+
+// removeKeyTranslation removes translation of key to language if it still has value and records the removal
+// in history, reports whether it was removed
+func (s *Service) removeKeyTranslation(ctx context.Context, key string, language string, value string) (bool, error) {
+	removed, err := s.repo.RemoveKeyTranslation(ctx, key, language, value)
+	if err != nil || !removed {
+		return false, err
+	}
+
+	s.recordHistory(ctx,
+		&TranslationKey{Key: key, Translations: map[string]string{language: value}},
+		&TranslationKey{Key: key, Translations: make(map[string]string)},
+	)
+	return true, nil
+}
+
+// CompleteTranslationRequest marks a translation request as completed,
+// or as partially completed when some of its keys failed to translate.
+// Reports whether it was marked, requests that finished meanwhile, e.g. were cancelled, keep their status.
+func (s *Service) CompleteTranslationRequest(ctx context.Context, requestID uuid.UUID) (bool, error) {
+	request, err := s.repo.GetRequestByID(ctx, requestID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get request: %w", err)
+	}
+
+	progress, err := s.GetRequestProgress(ctx, request)
+	if err != nil {
+		return false, err
+	}
+
+	return s.repo.FinishRequest(ctx, requestID, func(request *TranslationRequest) {
+		switch {
+		case progress.Failed == 0:
+			request.MarkAsCompleted()
+		case progress.Done+progress.Skipped == 0:
+			// Nothing was translated or reused
+			request.MarkAsFailed()
+		default:
+			request.MarkAsPartiallyCompleted()
+		}
+	})
+}
+
+// FailTranslationRequest marks a translation request as failed with the error that stopped its processing
+func (s *Service) FailTranslationRequest(ctx context.Context, requestID uuid.UUID, reason string) error {
 	request, err := s.repo.GetRequestByID(ctx, requestID)
 	if err != nil {
 		return fmt.Errorf("failed to get request: %w", err)
 	}
 
-	// Mark as completed
-	request.MarkAsCompleted()
-	return s.repo.UpdateRequestStatus(ctx, requestID, request.Status)
+	if request.Status.IsFinal() {
+		return fmt.Errorf("request already finished in status: %s", request.Status)
+	}
+
+	request.MarkAsFailedWithError(reason)
+	return s.repo.SaveRequest(ctx, request)
+}
+
+// ReopenTranslationRequest returns a failed translation request to pending, so it can be queued again
+func (s *Service) ReopenTranslationRequest(ctx context.Context, requestID uuid.UUID) (*TranslationRequest, error) {
+	request, err := s.repo.GetRequestByID(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get request: %w", err)
+	}
+
+	if request.Status != StatusFailed {
+		return nil, fmt.Errorf("request cannot be reopened in status: %s", request.Status)
+	}
+
+	request.Reopen()
+	if err := s.repo.SaveRequest(ctx, request); err != nil {
+		return nil, fmt.Errorf("failed to save request: %w", err)
+	}
+
+	return request, nil
 }
 
 // GetIncompleteRequests gets all requests that are not completed, failed, or cancelled
@@ -282,6 +421,11 @@ func (s *Service) GetIncompleteRequests(ctx context.Context) ([]*TranslationRequ
 	return s.repo.GetIncompleteRequests(ctx)
 }
 
+// GetTranslationKey gets translation key with its translations
+func (s *Service) GetTranslationKey(ctx context.Context, key string) (*TranslationKey, error) {
+	return s.repo.GetTranslationKey(ctx, key)
+}
+
 // DeleteTranslationKey deletes translation key and all its translations
 func (s *Service) DeleteTranslationKey(ctx context.Context, key string) error {
 	// Check if key exists
@@ -294,14 +438,195 @@ func (s *Service) DeleteTranslationKey(ctx context.Context, key string) error {
 		return fmt.Errorf("translation key not found")
 	}
 
+	before, err := s.repo.GetTranslationKey(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to get translation key: %w", err)
+	}
+
 	// Delete the key and all its translations
 	if err := s.repo.DeleteTranslationKey(ctx, key); err != nil {
 		return fmt.Errorf("failed to delete translation key: %w", err)
 	}
 
+	s.recordHistory(ctx, before, nil)
+	return nil
+}
+
+// SaveTranslationKey saves translation key and records changed values in history
+func (s *Service) SaveTranslationKey(ctx context.Context, key *TranslationKey) error {
+	before, err := s.repo.GetTranslationKey(ctx, key.Key)
+	if err != nil {
+		// Key doesn't exist yet
+		before = nil
+	}
+
+	if err := s.repo.SaveTranslationKey(ctx, key); err != nil {
+		return err
+	}
+
+	s.recordHistory(ctx, before, key)
+	return nil
+}
+
+// UpdateTranslationKeyValue updates translation key value, clears its translations and records changes in history
+func (s *Service) UpdateTranslationKeyValue(ctx context.Context, key string, newValue string) error {
+	before, err := s.repo.GetTranslationKey(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to get existing translation key: %w", err)
+	}
+
+	if err := s.repo.UpdateTranslationKeyValue(ctx, key, newValue); err != nil {
+		return err
+	}
+
+	s.recordHistory(ctx, before, &TranslationKey{
+		Key:          key,
+		Value:        newValue,
+		Translations: make(map[string]string),
+	})
 	return nil
 }
 
+// recordHistory appends changes between two states of a key to its history
+func (s *Service) recordHistory(ctx context.Context, before, after *TranslationKey) []*HistoryEntry {
+	entries := diffKeys(before, after, ChangeSourceFromContext(ctx))
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if err := s.repo.AppendHistory(ctx, entries); err != nil {
+		// Log error but don't fail the change itself
+		fmt.Printf("Failed to record history for key %s: %v\n", entries[0].Key, err)
+		return nil
+	}
+
+	return entries
+}
+
+// GetKeyHistory gets history of translation key, optionally filtered by language
+func (s *Service) GetKeyHistory(ctx context.Context, key string, language string) ([]*HistoryEntry, error) {
+	history, err := s.repo.GetKeyHistory(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key history: %w", err)
+	}
+
+	if language == "" {
+		return history, nil
+	}
+
+	var filtered []*HistoryEntry
+	for _, entry := range history {
+		if entry.Language == language {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	return filtered, nil
+}
+
+// RollbackTranslations restores source values and translations to their state at given time.
+// Empty key rolls back all keys, empty language rolls back source value and all translations.
+func (s *Service) RollbackTranslations(ctx context.Context, key string, language string, at time.Time) (*RollbackResult, error) {
+	keys := []string{key}
+	if key == "" {
+		var err error
+		keys, err = s.repo.GetHistoryKeys(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get history keys: %w", err)
+		}
+		sort.Strings(keys)
+	}
+
+	// Changes made by rollback are attributed to it rather than to the original provider
+	ctx = WithChangeProvider(ctx, "rollback", "")
+
+	result := &RollbackResult{}
+	for _, keyName := range keys {
+		changes, err := s.rollbackKey(ctx, keyName, language, at)
+		if err != nil {
+			return result, fmt.Errorf("failed to roll back key %s: %w", keyName, err)
+		}
+		result.Changes = append(result.Changes, changes...)
+	}
+
+	return result, nil
+}
+
+// rollbackKey restores one key to its state at given time
+func (s *Service) rollbackKey(ctx context.Context, key string, language string, at time.Time) ([]*HistoryEntry, error) {
+	history, err := s.repo.GetKeyHistory(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key history: %w", err)
+	}
+
+	// The first change after the target time holds the value each field had at that time
+	restore := make(map[string]*HistoryEntry)
+	for _, entry := range history {
+		if !entry.CreatedAt.After(at) {
+			continue
+		}
+		if language != "" && entry.Language != language {
+			continue
+		}
+		if _, exists := restore[entry.Language]; !exists {
+			restore[entry.Language] = entry
+		}
+	}
+
+	if len(restore) == 0 {
+		return nil, nil
+	}
+
+	before, err := s.repo.GetTranslationKey(ctx, key)
+	if err != nil {
+		// Key was deleted after the target time
+		before = nil
+	}
+
+	after := &TranslationKey{
+		Key:          key,
+		Translations: make(map[string]string),
+	}
+	if before != nil {
+		after.Value = before.Value
+		for lang, value := range before.Translations {
+			after.Translations[lang] = value
+		}
+	}
+
+	exists := before != nil
+	for lang, entry := range restore {
+		if lang == SourceLanguage {
+			exists = entry.Action != HistoryActionCreated
+			after.Value = entry.PreviousValue
+			continue
+		}
+
+		if entry.Action == HistoryActionCreated {
+			delete(after.Translations, lang)
+		} else {
+			after.Translations[lang] = entry.PreviousValue
+		}
+	}
+
+	if !exists {
+		if before == nil {
+			return nil, nil
+		}
+
+		// Key didn't exist at the target time
+		if err := s.repo.DeleteTranslationKey(ctx, key); err != nil {
+			return nil, fmt.Errorf("failed to delete translation key: %w", err)
+		}
+		return s.recordHistory(ctx, before, nil), nil
+	}
+
+	if err := s.repo.SaveTranslationKey(ctx, after); err != nil {
+		return nil, fmt.Errorf("failed to save translation key: %w", err)
+	}
+	return s.recordHistory(ctx, before, after), nil
+}
+
 // CacheTranslationsResult represents the result of caching translations
 type CacheTranslationsResult struct {
 	SuccessCount int
@@ -315,6 +640,9 @@ func (s *Service) CacheTranslations(ctx context.Context, translations map[string
 		SkippedKeys: []string{},
 	}
 
+	// Imported translations are attributed to the import rather than to a translation provider
+	ctx = WithChangeProvider(ctx, "import", "")
+
 	// First, collect all keys and their translations
 	keyTranslations := make(map[string]map[string]string)
 
@@ -356,7 +684,7 @@ func (s *Service) CacheTranslations(ctx context.Context, translations map[string
 				newKey.Translations[lang] = translationValue
 			}
 
-			if err := s.repo.SaveTranslationKey(ctx, newKey); err != nil {
+			if err := s.SaveTranslationKey(ctx, newKey); err != nil {
 				return result, fmt.Errorf("failed to save new translation key %s: %w", keyName, err)
 			}
 			result.SuccessCount++
@@ -370,7 +698,7 @@ func (s *Service) CacheTranslations(ctx context.Context, translations map[string
 				existingKey.Translations[lang] = translationValue
 			}
 
-			if err := s.repo.SaveTranslationKey(ctx, existingKey); err != nil {
+			if err := s.SaveTranslationKey(ctx, existingKey); err != nil {
 				return result, fmt.Errorf("failed to update translation key %s: %w", keyName, err)
 			}
 			result.SuccessCount++
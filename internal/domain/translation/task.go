@@ -0,0 +1,45 @@
+package translation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TranslationTask represents translation task. A request task carries the whole request and is fanned out
+// into chunk tasks, each translating a few keys of the request to one language.
+type TranslationTask struct {
+	RequestID  uuid.UUID         `json:"request_id"`
+	SourceData map[string]string `json:"source_data,omitempty"`
+	Languages  []string          `json:"languages"`
+	Project    string            `json:"project,omitempty"`
+	// Priority from 0 to MaxPriority, higher priority tasks are delivered first
+	Priority uint8 `json:"priority,omitempty"`
+
+	// Chunk task fields
+	PlanID *uuid.UUID `json:"plan_id,omitempty"`
+	Chunk  int        `json:"chunk,omitempty"`
+	Keys   []string   `json:"keys,omitempty"`
+}
+
+// IsChunk reports whether task is a chunk of a request
+func (t *TranslationTask) IsChunk() bool {
+	return t.PlanID != nil
+}
+
+// RetryPolicy represents how failed tasks are retried before they are dead-lettered
+type RetryPolicy struct {
+	// MaxAttempts is number of attempts to process a task, including the first one
+	MaxAttempts int
+	// Delay is time a failed task waits before the next attempt
+	Delay time.Duration
+}
+
+// DeadLetter represents task that failed all attempts
+type DeadLetter struct {
+	ID             string           `json:"id"`
+	Task           *TranslationTask `json:"task,omitempty"`
+	Attempts       int              `json:"attempts"`
+	Error          string           `json:"error"`
+	DeadLetteredAt time.Time        `json:"dead_lettered_at"`
+}
@@ -0,0 +1,37 @@
+package translation
+
+// User represents person authenticated with identity provider token
+type User struct {
+	// Subject identifies user at identity provider
+	Subject string
+	Email   string
+	Name    string
+	// Roles are granted in all projects
+	Roles []Role
+	// Memberships maps projects to roles granted in them
+	Memberships map[string][]Role
+}
+
+// Principal returns access of user. Roles granted in a project don't grant actions on shared data,
+// unknown roles grant nothing.
+func (u *User) Principal() *Principal {
+	name := u.Name
+	if name == "" {
+		name = u.Email
+	}
+
+	principal := &Principal{
+		ID:             "user:" + u.Subject,
+		Name:           name,
+		Actions:        grantedActions(roleActions, u.Roles),
+		ProjectActions: make(map[string][]Action, len(u.Memberships)),
+	}
+
+	for project, roles := range u.Memberships {
+		if actions := projectActions(grantedActions(roleActions, roles)); len(actions) > 0 {
+			principal.ProjectActions[project] = actions
+		}
+	}
+
+	return principal
+}
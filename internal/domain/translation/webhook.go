@@ -0,0 +1,314 @@
+package translation
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEvent represents event a webhook can subscribe to
+type WebhookEvent string
+
+const (
+	WebhookEventCompleted          WebhookEvent = "request.completed"
+	WebhookEventPartiallyCompleted WebhookEvent = "request.partially_completed"
+	WebhookEventFailed             WebhookEvent = "request.failed"
+	WebhookEventCancelled          WebhookEvent = "request.cancelled"
+)
+
+// webhookEvents maps final request statuses to webhook events
+var webhookEvents = map[RequestStatus]WebhookEvent{
+	StatusCompleted:          WebhookEventCompleted,
+	StatusPartiallyCompleted: WebhookEventPartiallyCompleted,
+	StatusFailed:             WebhookEventFailed,
+	StatusCancelled:          WebhookEventCancelled,
+}
+
+// WebhookEventForStatus returns webhook event emitted when request reaches status
+func WebhookEventForStatus(status RequestStatus) (WebhookEvent, bool) {
+	event, exists := webhookEvents[status]
+	return event, exists
+}
+
+// Webhook represents endpoint receiving events of requests of a project
+type Webhook struct {
+	ID        uuid.UUID      `json:"id"`
+	Project   string         `json:"project"`
+	URL       string         `json:"url"`
+	Secret    string         `json:"secret"`
+	Events    []WebhookEvent `json:"events,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// Subscribed reports whether webhook receives event, webhook without events receives all of them
+func (w *Webhook) Subscribed(event WebhookEvent) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, subscribed := range w.Events {
+		if subscribed == event {
+			return true
+		}
+	}
+	return false
+}
+
+// DeliveryStatus represents status of webhook delivery
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusSucceeded DeliveryStatus = "succeeded"
+	DeliveryStatusFailed    DeliveryStatus = "failed"
+)
+
+// DeliveryAttempt represents one attempt to deliver webhook payload
+type DeliveryAttempt struct {
+	StatusCode int           `json:"status_code,omitempty"`
+	Error      string        `json:"error,omitempty"`
+	Duration   time.Duration `json:"duration"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+// WebhookDelivery represents payload delivered to a webhook or request callback URL, with its attempt log
+type WebhookDelivery struct {
+	ID            uuid.UUID          `json:"id"`
+	WebhookID     *uuid.UUID         `json:"webhook_id,omitempty"`
+	RequestID     uuid.UUID          `json:"request_id"`
+	URL           string             `json:"url"`
+	Event         WebhookEvent       `json:"event"`
+	Payload       []byte             `json:"payload"`
+	Status        DeliveryStatus     `json:"status"`
+	Attempts      []*DeliveryAttempt `json:"attempts"`
+	NextAttemptAt *time.Time         `json:"next_attempt_at,omitempty"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+}
+
+// RecordAttempt appends attempt result; failed attempts are retried with exponential backoff until maxAttempts
+func (d *WebhookDelivery) RecordAttempt(attempt *DeliveryAttempt, maxAttempts int, baseDelay time.Duration) {
+	d.Attempts = append(d.Attempts, attempt)
+	d.UpdatedAt = time.Now()
+	d.NextAttemptAt = nil
+
+	if attempt.Succeeded() {
+		d.Status = DeliveryStatusSucceeded
+		return
+	}
+
+	if len(d.Attempts) >= maxAttempts {
+		d.Status = DeliveryStatusFailed
+		return
+	}
+
+	// Delays double with every failed attempt: base, 2*base, 4*base...
+	next := attempt.CreatedAt.Add(baseDelay << (len(d.Attempts) - 1))
+	d.NextAttemptAt = &next
+	d.Status = DeliveryStatusPending
+}
+
+// Abandon appends attempt that can never succeed and marks delivery as failed without further retries
+func (d *WebhookDelivery) Abandon(attempt *DeliveryAttempt) {
+	d.Attempts = append(d.Attempts, attempt)
+	d.UpdatedAt = time.Now()
+	d.NextAttemptAt = nil
+	d.Status = DeliveryStatusFailed
+}
+
+// Succeeded reports whether receiver accepted payload
+func (a *DeliveryAttempt) Succeeded() bool {
+	return a.Error == "" && a.StatusCode >= 200 && a.StatusCode < 300
+}
+
+// SignPayload returns hex HMAC-SHA256 signature of "<timestamp>.<payload>" with secret
+func SignPayload(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ValidateWebhookURL checks that URL is an absolute HTTP(S) URL
+func ValidateWebhookURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("invalid webhook URL")
+	}
+	return nil
+}
+
+// CreateWebhook registers webhook for project, secret is generated when empty
+func (s *Service) CreateWebhook(ctx context.Context, project string, webhookURL string, secret string, events []WebhookEvent) (*Webhook, error) {
+	if err := ValidateWebhookURL(webhookURL); err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		known := false
+		for _, webhookEvent := range webhookEvents {
+			if event == webhookEvent {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, fmt.Errorf("invalid webhook event")
+		}
+	}
+
+	if secret == "" {
+		buf := make([]byte, 32)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+		secret = hex.EncodeToString(buf)
+	}
+
+	webhook := &Webhook{
+		ID:        uuid.New(),
+		Project:   project,
+		URL:       webhookURL,
+		Secret:    secret,
+		Events:    events,
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.repo.SaveWebhook(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("failed to save webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// GetWebhook gets webhook by ID
+func (s *Service) GetWebhook(ctx context.Context, id uuid.UUID) (*Webhook, error) {
+	return s.repo.GetWebhook(ctx, id)
+}
+
+// GetWebhooks gets webhooks of project ordered by creation time, all webhooks when project is nil
+func (s *Service) GetWebhooks(ctx context.Context, project *string) ([]*Webhook, error) {
+	webhooks, err := s.repo.GetWebhooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+
+	var filtered []*Webhook
+	for _, webhook := range webhooks {
+		if project == nil || webhook.Project == *project {
+			filtered = append(filtered, webhook)
+		}
+	}
+
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].CreatedAt.Before(filtered[j].CreatedAt)
+	})
+
+	return filtered, nil
+}
+
+// DeleteWebhook deletes webhook, its delivery log is kept
+func (s *Service) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.repo.GetWebhook(ctx, id); err != nil {
+		return err
+	}
+
+	return s.repo.DeleteWebhook(ctx, id)
+}
+
+// CreateDeliveries creates pending deliveries of event payload to webhooks of request project and its callback URL
+func (s *Service) CreateDeliveries(ctx context.Context, request *TranslationRequest, event WebhookEvent, payload []byte) ([]*WebhookDelivery, error) {
+	project := request.Project
+	webhooks, err := s.GetWebhooks(ctx, &project)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	newDelivery := func(webhookID *uuid.UUID, deliveryURL string) *WebhookDelivery {
+		return &WebhookDelivery{
+			ID:            uuid.New(),
+			WebhookID:     webhookID,
+			RequestID:     request.ID,
+			URL:           deliveryURL,
+			Event:         event,
+			Payload:       payload,
+			Status:        DeliveryStatusPending,
+			NextAttemptAt: &now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+	}
+
+	var deliveries []*WebhookDelivery
+	for _, webhook := range webhooks {
+		if webhook.Subscribed(event) {
+			webhookID := webhook.ID
+			deliveries = append(deliveries, newDelivery(&webhookID, webhook.URL))
+		}
+	}
+	if request.CallbackURL != "" {
+		deliveries = append(deliveries, newDelivery(nil, request.CallbackURL))
+	}
+
+	for _, delivery := range deliveries {
+		if err := s.repo.SaveDelivery(ctx, delivery); err != nil {
+			return nil, fmt.Errorf("failed to save delivery: %w", err)
+		}
+	}
+
+	return deliveries, nil
+}
+
+// GetDelivery gets webhook delivery by ID
+func (s *Service) GetDelivery(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error) {
+	return s.repo.GetDelivery(ctx, id)
+}
+
+// GetDeliveries gets deliveries of request or webhook ordered by creation time
+func (s *Service) GetDeliveries(ctx context.Context, requestID *uuid.UUID, webhookID *uuid.UUID) ([]*WebhookDelivery, error) {
+	deliveries, err := s.repo.GetDeliveries(ctx, requestID, webhookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deliveries: %w", err)
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt.Before(deliveries[j].CreatedAt)
+	})
+
+	return deliveries, nil
+}
+
+// SaveDelivery saves delivery and schedules its next attempt if any
+func (s *Service) SaveDelivery(ctx context.Context, delivery *WebhookDelivery) error {
+	return s.repo.SaveDelivery(ctx, delivery)
+}
+
+// ClaimDueDeliveries claims deliveries whose next attempt is due, each delivery is claimed by one caller only
+func (s *Service) ClaimDueDeliveries(ctx context.Context, limit int) ([]*WebhookDelivery, error) {
+	ids, err := s.repo.ClaimDueDeliveries(ctx, time.Now(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim deliveries: %w", err)
+	}
+
+	var deliveries []*WebhookDelivery
+	for _, id := range ids {
+		delivery, err := s.repo.GetDelivery(ctx, id)
+		if err != nil {
+			fmt.Printf("Failed to get claimed delivery %s: %v\n", id, err)
+			continue
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
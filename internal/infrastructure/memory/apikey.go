@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"translation/internal/domain/translation"
+)
+
+// SaveAPIKey saves API key in memory
+func (r *Repository) SaveAPIKey(ctx context.Context, key *translation.APIKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	clone := cloneAPIKey(key)
+	// Use is recorded apart from the key
+	if existing, exists := r.apiKeys[key.ID]; exists {
+		clone.LastUsedAt = existing.LastUsedAt
+	}
+	r.apiKeys[key.ID] = clone
+	r.apiKeyHashes[key.Hash] = key.ID
+	return nil
+}
+
+// GetAPIKey gets API key by ID from memory
+func (r *Repository) GetAPIKey(ctx context.Context, id string) (*translation.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	key, exists := r.apiKeys[id]
+	if !exists {
+		return nil, fmt.Errorf("api key not found")
+	}
+
+	return cloneAPIKey(key), nil
+}
+
+// GetAPIKeyByHash gets API key by hash of its secret from memory
+func (r *Repository) GetAPIKeyByHash(ctx context.Context, hash string) (*translation.APIKey, error) {
+	r.mu.RLock()
+	id, exists := r.apiKeyHashes[hash]
+	r.mu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("api key not found")
+	}
+	return r.GetAPIKey(ctx, id)
+}
+
+// GetAPIKeys gets all API keys from memory
+func (r *Repository) GetAPIKeys(ctx context.Context) ([]*translation.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var keys []*translation.APIKey
+	for _, key := range r.apiKeys {
+		keys = append(keys, cloneAPIKey(key))
+	}
+
+	return keys, nil
+}
+
+// TouchAPIKey records use of API key in memory
+func (r *Repository) TouchAPIKey(ctx context.Context, id string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if key, exists := r.apiKeys[id]; exists {
+		key.LastUsedAt = &at
+	}
+	return nil
+}
+
+// cloneAPIKey returns copy of API key that doesn't share projects and permissions with it
+func cloneAPIKey(key *translation.APIKey) *translation.APIKey {
+	clone := *key
+	clone.Projects = append([]string(nil), key.Projects...)
+	clone.Permissions = append([]translation.Permission(nil), key.Permissions...)
+	return &clone
+}
@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+)
+
+// createdBetween reports whether creation time is between from and to, zero times are open
+func createdBetween(createdAt time.Time, from time.Time, to time.Time) bool {
+	return (from.IsZero() || !createdAt.Before(from)) && (to.IsZero() || !createdAt.After(to))
+}
+
+// GetRequestIDs gets IDs of requests created between from and to from memory
+func (r *Repository) GetRequestIDs(ctx context.Context, from time.Time, to time.Time) ([]uuid.UUID, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var requests []*translation.TranslationRequest
+	for _, request := range r.requests {
+		if createdBetween(request.CreatedAt, from, to) {
+			requests = append(requests, request)
+		}
+	}
+	sort.Slice(requests, func(i, j int) bool {
+		return requests[i].CreatedAt.Before(requests[j].CreatedAt)
+	})
+
+	ids := make([]uuid.UUID, 0, len(requests))
+	for _, request := range requests {
+		ids = append(ids, request.ID)
+	}
+
+	return ids, nil
+}
+
+// DeleteRequest deletes request with its key outcomes and chunk plan from memory
+func (r *Repository) DeleteRequest(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.requests, id)
+	delete(r.results, id)
+	delete(r.chunks, id)
+	return nil
+}
+
+// SaveRequestSummary saves summary of archived request in memory
+func (r *Repository) SaveRequestSummary(ctx context.Context, summary *translation.RequestSummary) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.summaries[summary.ID] = cloneSummary(summary)
+	return nil
+}
+
+// GetRequestSummaries gets summaries of archived requests created between from and to from memory
+func (r *Repository) GetRequestSummaries(ctx context.Context, from time.Time, to time.Time) ([]*translation.RequestSummary, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var summaries []*translation.RequestSummary
+	for _, summary := range r.summaries {
+		if createdBetween(summary.CreatedAt, from, to) {
+			summaries = append(summaries, cloneSummary(summary))
+		}
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].CreatedAt.Before(summaries[j].CreatedAt)
+	})
+
+	return summaries, nil
+}
+
+// DeleteRequestSummariesBefore deletes summaries of archived requests created before given time from memory
+func (r *Repository) DeleteRequestSummariesBefore(ctx context.Context, before time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := 0
+	for id, summary := range r.summaries {
+		if summary.CreatedAt.Before(before) {
+			delete(r.summaries, id)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+// cloneSummary returns copy of summary that doesn't share languages and progress with it
+func cloneSummary(summary *translation.RequestSummary) *translation.RequestSummary {
+	clone := *summary
+	clone.Languages = append([]string(nil), summary.Languages...)
+	if summary.Progress != nil {
+		progress := *summary.Progress
+		clone.Progress = &progress
+	}
+	return &clone
+}
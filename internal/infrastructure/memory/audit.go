@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"translation/internal/domain/translation"
+)
+
+// AppendAuditEntry appends entry to audit log in memory
+func (r *Repository) AppendAuditEntry(ctx context.Context, entry *translation.AuditEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.auditLog = append(r.auditLog, cloneAuditEntry(entry))
+	return nil
+}
+
+// CountAuditEntries counts audit entries recorded between from and to in memory
+func (r *Repository) CountAuditEntries(ctx context.Context, from time.Time, to time.Time) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+	for _, entry := range r.auditLog {
+		if createdBetween(entry.CreatedAt, from, to) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// GetAuditEntries gets page of audit entries recorded between from and to from memory, entries are appended in order
+func (r *Repository) GetAuditEntries(ctx context.Context, from time.Time, to time.Time, reverse bool, offset int, limit int) ([]*translation.AuditEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var entries []*translation.AuditEntry
+	skipped := 0
+	for i := range r.auditLog {
+		entry := r.auditLog[i]
+		if reverse {
+			entry = r.auditLog[len(r.auditLog)-1-i]
+		}
+		if !createdBetween(entry.CreatedAt, from, to) {
+			continue
+		}
+		if skipped < offset {
+			skipped++
+			continue
+		}
+		if len(entries) == limit {
+			break
+		}
+		entries = append(entries, cloneAuditEntry(entry))
+	}
+
+	return entries, nil
+}
+
+// cloneAuditEntry returns copy of entry that doesn't share its summaries
+func cloneAuditEntry(entry *translation.AuditEntry) *translation.AuditEntry {
+	clone := *entry
+	clone.Before = maps.Clone(entry.Before)
+	clone.After = maps.Clone(entry.After)
+	return &clone
+}
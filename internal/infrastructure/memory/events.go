@@ -0,0 +1,130 @@
+package memory
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+)
+
+// eventBufferSize is number of events buffered per subscriber before events are dropped
+const eventBufferSize = 256
+
+// EventBus represents in-process request event bus with the same contract as Redis pub/sub
+type EventBus struct {
+	mu          sync.Mutex
+	subscribers map[uuid.UUID]map[chan *translation.RequestEvent]bool
+	cancelled   map[chan uuid.UUID]bool
+}
+
+// NewEventBus creates a new in-process event bus
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subscribers: make(map[uuid.UUID]map[chan *translation.RequestEvent]bool),
+		cancelled:   make(map[chan uuid.UUID]bool),
+	}
+}
+
+// PublishEvent delivers event to current subscribers of its request
+func (b *EventBus) PublishEvent(ctx context.Context, event *translation.RequestEvent) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers[event.RequestID] {
+		clone := *event
+		select {
+		case ch <- &clone:
+		default:
+			// Slow subscriber, like Redis pub/sub the event is lost for it
+			log.Printf("Dropped %s event for request ID %s: subscriber is too slow", event.Type, event.RequestID)
+		}
+	}
+
+	return nil
+}
+
+// SubscribeEvents subscribes to events of request until ctx is done or returned cancel is called
+func (b *EventBus) SubscribeEvents(ctx context.Context, requestID uuid.UUID) (<-chan *translation.RequestEvent, func(), error) {
+	ch := make(chan *translation.RequestEvent, eventBufferSize)
+
+	b.mu.Lock()
+	if b.subscribers[requestID] == nil {
+		b.subscribers[requestID] = make(map[chan *translation.RequestEvent]bool)
+	}
+	b.subscribers[requestID][ch] = true
+	b.mu.Unlock()
+
+	done := make(chan struct{})
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			delete(b.subscribers[requestID], ch)
+			if len(b.subscribers[requestID]) == 0 {
+				delete(b.subscribers, requestID)
+			}
+			close(ch)
+			close(done)
+		})
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-done:
+		}
+	}()
+
+	return ch, cancel, nil
+}
+
+// Subscribers returns number of current subscribers of request
+func (b *EventBus) Subscribers(requestID uuid.UUID) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.subscribers[requestID])
+}
+
+// PublishCancellation delivers cancellation of request to current cancellation subscribers
+func (b *EventBus) PublishCancellation(ctx context.Context, requestID uuid.UUID) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.cancelled {
+		select {
+		case ch <- requestID:
+		default:
+			log.Printf("Dropped cancellation of request ID %s: subscriber is too slow", requestID)
+		}
+	}
+
+	return nil
+}
+
+// SubscribeCancellations subscribes to cancelled request IDs until ctx is done
+func (b *EventBus) SubscribeCancellations(ctx context.Context) (<-chan uuid.UUID, error) {
+	ch := make(chan uuid.UUID, eventBufferSize)
+
+	b.mu.Lock()
+	b.cancelled[ch] = true
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		delete(b.cancelled, ch)
+		close(ch)
+	}()
+
+	return ch, nil
+}
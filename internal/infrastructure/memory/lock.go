@@ -0,0 +1,42 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Locker represents in-process locks with the same contract as Redis locker, for single-node setups
+type Locker struct {
+	mu    sync.Mutex
+	locks map[string]time.Time
+}
+
+// NewLocker creates a new in-process locker
+func NewLocker() *Locker {
+	return &Locker{
+		locks: make(map[string]time.Time),
+	}
+}
+
+// AcquireLock takes lock unless it is held and not expired
+func (l *Locker) AcquireLock(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if expiresAt, held := l.locks[name]; held && expiresAt.After(time.Now()) {
+		return false, nil
+	}
+
+	l.locks[name] = time.Now().Add(ttl)
+	return true, nil
+}
+
+// ReleaseLock releases lock
+func (l *Locker) ReleaseLock(ctx context.Context, name string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.locks, name)
+	return nil
+}
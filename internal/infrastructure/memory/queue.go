@@ -0,0 +1,307 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+)
+
+// queuedTask represents serialized task with its request, priority and number of failed attempts
+type queuedTask struct {
+	data      []byte
+	requestID uuid.UUID
+	priority  uint8
+	sequence  int64
+	attempts  int
+}
+
+// Queue represents in-process task queue with the same contract as RabbitMQ service.
+// Tasks are lost on restart, so it suits single-node setups only.
+type Queue struct {
+	capacity int
+	retry    translation.RetryPolicy
+
+	mu sync.Mutex
+	// pending is ordered by priority and then by the order tasks were queued in
+	pending  []queuedTask
+	sequence int64
+	// requests counts tasks of each request until they succeed or are dead-lettered
+	requests map[uuid.UUID]int
+
+	// deadMu guards dead-lettered tasks separately, so they can be requeued while held
+	deadMu sync.Mutex
+	dead   []*translation.DeadLetter
+
+	// notify wakes up a worker when a task is queued
+	notify chan struct{}
+}
+
+// NewQueue creates a new in-process queue with given capacity and retry policy
+func NewQueue(capacity int, retry translation.RetryPolicy) *Queue {
+	return &Queue{
+		capacity: capacity,
+		retry:    retry,
+		requests: make(map[uuid.UUID]int),
+		notify:   make(chan struct{}, 1),
+	}
+}
+
+// PublishTask publishes task to queue
+func (q *Queue) PublishTask(ctx context.Context, task *translation.TranslationTask) error {
+	// Tasks are serialized like on the wire so consumers never share state with publishers
+	data, err := json.Marshal(task)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task: %w", err)
+	}
+
+	if err := q.push(ctx, queuedTask{data: data, requestID: task.RequestID, priority: task.Priority}); err != nil {
+		return fmt.Errorf("failed to publish task: %w", err)
+	}
+
+	log.Printf("Published translation task for request ID: %s", task.RequestID)
+	return nil
+}
+
+// push adds task to queue behind queued tasks of the same or higher priority without blocking
+func (q *Queue) push(ctx context.Context, task queuedTask) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	q.mu.Lock()
+	if len(q.pending) >= q.capacity {
+		q.mu.Unlock()
+		return fmt.Errorf("queue is full")
+	}
+
+	q.sequence++
+	task.sequence = q.sequence
+	i := sort.Search(len(q.pending), func(i int) bool {
+		return q.pending[i].priority < task.priority
+	})
+	q.pending = append(q.pending, queuedTask{})
+	copy(q.pending[i+1:], q.pending[i:])
+	q.pending[i] = task
+	// Retried tasks are already counted
+	if task.attempts == 0 {
+		q.requests[task.requestID]++
+	}
+	q.mu.Unlock()
+
+	q.wake()
+	return nil
+}
+
+// pop takes the first queued task
+func (q *Queue) pop() (queuedTask, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.pending) == 0 {
+		return queuedTask{}, false
+	}
+
+	task := q.pending[0]
+	q.pending = q.pending[1:]
+	if len(q.pending) > 0 {
+		// Let another worker pick up the rest
+		q.wake()
+	}
+
+	return task, true
+}
+
+// forget stops counting task of request once it succeeded, was dead-lettered or lost
+func (q *Queue) forget(requestID uuid.UUID) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.requests[requestID]--
+	if q.requests[requestID] <= 0 {
+		delete(q.requests, requestID)
+	}
+}
+
+// wake notifies one waiting worker
+func (q *Queue) wake() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// ConsumeTasks consumes tasks from queue with given number of workers until ctx is done, then waits
+// for tasks in progress to finish. Failed tasks are retried after retry delay; once all attempts fail,
+// the task is dead-lettered and deadLetter is called with the last error.
+func (q *Queue) ConsumeTasks(ctx context.Context, workers int, handler func(*translation.TranslationTask) error, deadLetter func(*translation.TranslationTask, error)) error {
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				if queued, ok := q.pop(); ok {
+					q.handle(ctx, queued, handler, deadLetter)
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-q.notify:
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// handle processes one task and schedules retry or dead-letters it on failure
+func (q *Queue) handle(ctx context.Context, queued queuedTask, handler func(*translation.TranslationTask) error, deadLetter func(*translation.TranslationTask, error)) {
+	var task translation.TranslationTask
+	if err := json.Unmarshal(queued.data, &task); err != nil {
+		log.Printf("Failed to unmarshal task: %v", err)
+		q.moveToDeadLetters(queued.data, queued.attempts+1, err)
+		q.forget(queued.requestID)
+		return
+	}
+
+	log.Printf("Processing translation task for request ID: %s", task.RequestID)
+
+	err := handler(&task)
+	if err == nil {
+		q.forget(queued.requestID)
+		log.Printf("Successfully processed translation task for request ID: %s", task.RequestID)
+		return
+	}
+
+	queued.attempts++
+	log.Printf("Failed to process task for request ID %s (attempt %d/%d): %v", task.RequestID, queued.attempts, q.retry.MaxAttempts, err)
+
+	if queued.attempts < q.retry.MaxAttempts {
+		// Retry after delay like RabbitMQ retry queue does
+		time.AfterFunc(q.retry.Delay, func() {
+			if err := q.push(ctx, queued); err != nil {
+				log.Printf("Failed to retry task for request ID %s: %v", task.RequestID, err)
+				q.forget(queued.requestID)
+			}
+		})
+		return
+	}
+
+	q.moveToDeadLetters(queued.data, queued.attempts, err)
+	q.forget(queued.requestID)
+	deadLetter(&task, err)
+}
+
+// moveToDeadLetters keeps task that failed all attempts
+func (q *Queue) moveToDeadLetters(data []byte, attempts int, cause error) {
+	letter := &translation.DeadLetter{
+		ID:             uuid.New().String(),
+		Attempts:       attempts,
+		Error:          cause.Error(),
+		DeadLetteredAt: time.Now(),
+	}
+
+	var task translation.TranslationTask
+	if err := json.Unmarshal(data, &task); err == nil {
+		letter.Task = &task
+	}
+
+	q.deadMu.Lock()
+	defer q.deadMu.Unlock()
+
+	q.dead = append(q.dead, letter)
+	log.Printf("Task moved to dead-letter queue after %d attempts: %v", attempts, cause)
+}
+
+// GetDeadLetters returns up to limit dead-lettered tasks without removing them
+func (q *Queue) GetDeadLetters(ctx context.Context, limit int) ([]*translation.DeadLetter, error) {
+	q.deadMu.Lock()
+	defer q.deadMu.Unlock()
+
+	deadLetters := make([]*translation.DeadLetter, 0, len(q.dead))
+	for _, dead := range q.dead {
+		if len(deadLetters) == limit {
+			break
+		}
+		letter := *dead
+		deadLetters = append(deadLetters, &letter)
+	}
+
+	return deadLetters, nil
+}
+
+// ProcessDeadLetters calls handler for dead-lettered tasks with given IDs, all of them when ids is empty.
+// Tasks are removed once handler succeeds. Returns number of removed tasks.
+func (q *Queue) ProcessDeadLetters(ctx context.Context, ids []string, handler func(*translation.DeadLetter) error) (int, error) {
+	q.deadMu.Lock()
+	defer q.deadMu.Unlock()
+
+	selected := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		selected[id] = true
+	}
+
+	processed := 0
+	var handlerErr error
+	var kept []*translation.DeadLetter
+	for _, dead := range q.dead {
+		if (len(ids) > 0 && !selected[dead.ID]) || handlerErr != nil {
+			kept = append(kept, dead)
+			continue
+		}
+
+		letter := *dead
+		if err := handler(&letter); err != nil {
+			handlerErr = err
+			kept = append(kept, dead)
+			continue
+		}
+		processed++
+	}
+	q.dead = kept
+
+	return processed, handlerErr
+}
+
+// PurgeDeadLetters removes all dead-lettered tasks and returns their number
+func (q *Queue) PurgeDeadLetters(ctx context.Context) (int, error) {
+	q.deadMu.Lock()
+	defer q.deadMu.Unlock()
+
+	count := len(q.dead)
+	q.dead = nil
+	return count, nil
+}
+
+// GetQueueInfo returns number of tasks waiting in queue
+func (q *Queue) GetQueueInfo() (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.pending), nil
+}
+
+// HasRequestTask reports whether a task of request is queued, waiting for retry or being processed
+func (q *Queue) HasRequestTask(ctx context.Context, requestID uuid.UUID) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.requests[requestID] > 0, nil
+}
+
+// Close closes queue
+func (q *Queue) Close() error {
+	return nil
+}
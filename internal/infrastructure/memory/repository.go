@@ -0,0 +1,733 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+)
+
+// chunkPlan represents chunks of request translation and the ones already done
+type chunkPlan struct {
+	id    uuid.UUID
+	total int
+	done  map[int]bool
+}
+
+// backlogEntry represents scheduled chunk with the order it was added in
+type backlogEntry struct {
+	chunk    *translation.ScheduledChunk
+	sequence int64
+}
+
+// Repository implements repository interface in memory
+type Repository struct {
+	mu              sync.RWMutex
+	requests        map[uuid.UUID]*translation.TranslationRequest
+	summaries       map[uuid.UUID]*translation.RequestSummary
+	results         map[uuid.UUID]map[string]*translation.KeyResult
+	chunks          map[uuid.UUID]*chunkPlan
+	backlogs        map[string][]backlogEntry
+	inFlight        map[string]map[string]time.Time
+	backlogSequence int64
+	leases          map[uuid.UUID]time.Time
+	claims          map[string]requestClaim
+	responses       map[string]idempotentResponse
+	keys            map[string]*translation.TranslationKey
+	history         map[string][]*translation.HistoryEntry
+	changes         []*translation.HistoryEntry
+	releases        map[string]*translation.Release
+
+	webhooks   map[uuid.UUID]*translation.Webhook
+	deliveries map[uuid.UUID]*translation.WebhookDelivery
+	schedule   map[uuid.UUID]time.Time
+
+	apiKeys      map[string]*translation.APIKey
+	apiKeyHashes map[string]string
+
+	auditLog []*translation.AuditEntry
+}
+
+// NewRepository creates a new in-memory repository instance
+func NewRepository() *Repository {
+	return &Repository{
+		requests:  make(map[uuid.UUID]*translation.TranslationRequest),
+		summaries: make(map[uuid.UUID]*translation.RequestSummary),
+		results:   make(map[uuid.UUID]map[string]*translation.KeyResult),
+		chunks:    make(map[uuid.UUID]*chunkPlan),
+		backlogs:  make(map[string][]backlogEntry),
+		inFlight:  make(map[string]map[string]time.Time),
+		leases:    make(map[uuid.UUID]time.Time),
+		claims:    make(map[string]requestClaim),
+		responses: make(map[string]idempotentResponse),
+		keys:      make(map[string]*translation.TranslationKey),
+		history:   make(map[string][]*translation.HistoryEntry),
+		releases:  make(map[string]*translation.Release),
+
+		webhooks:   make(map[uuid.UUID]*translation.Webhook),
+		deliveries: make(map[uuid.UUID]*translation.WebhookDelivery),
+		schedule:   make(map[uuid.UUID]time.Time),
+
+		apiKeys:      make(map[string]*translation.APIKey),
+		apiKeyHashes: make(map[string]string),
+	}
+}
+
+// SaveRequest saves translation request in memory
+func (r *Repository) SaveRequest(ctx context.Context, request *translation.TranslationRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests[request.ID] = cloneRequest(request)
+	return nil
+}
+
+// GetRequestByID gets request by ID from memory
+func (r *Repository) GetRequestByID(ctx context.Context, id uuid.UUID) (*translation.TranslationRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	request, exists := r.requests[id]
+	if !exists {
+		return nil, fmt.Errorf("request not found")
+	}
+
+	return cloneRequest(request), nil
+}
+
+// UpdateRequestStatus updates request status in memory
+func (r *Repository) UpdateRequestStatus(ctx context.Context, id uuid.UUID, status translation.RequestStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	request, exists := r.requests[id]
+	if !exists {
+		return fmt.Errorf("request not found")
+	}
+
+	request.Status = status
+	request.UpdatedAt = time.Now()
+	return nil
+}
+
+// FinishRequest applies finish to request unless it already finished, reporting whether it was applied
+func (r *Repository) FinishRequest(ctx context.Context, id uuid.UUID, finish func(*translation.TranslationRequest)) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	request, exists := r.requests[id]
+	if !exists {
+		return false, fmt.Errorf("request not found")
+	}
+	if request.Status.IsFinal() {
+		return false, nil
+	}
+
+	finished := cloneRequest(request)
+	finish(finished)
+	r.requests[id] = finished
+	return true, nil
+}
+
+// SaveTranslationKey saves translation key in memory
+func (r *Repository) SaveTranslationKey(ctx context.Context, key *translation.TranslationKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.keys[key.Key] = cloneKey(key)
+	return nil
+}
+
+// GetTranslationKey gets translation key from memory
+func (r *Repository) GetTranslationKey(ctx context.Context, key string) (*translation.TranslationKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	translationKey, exists := r.keys[key]
+	if !exists {
+		return nil, fmt.Errorf("translation key not found")
+	}
+
+	return cloneKey(translationKey), nil
+}
+
+// SetKeyTranslation sets translation of key to language in memory
+func (r *Repository) SetKeyTranslation(ctx context.Context, key string, language string, value string) (string, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	translationKey, exists := r.keys[key]
+	if !exists {
+		return "", false, fmt.Errorf("translation key not found")
+	}
+
+	if translationKey.Translations == nil {
+		translationKey.Translations = make(map[string]string)
+	}
+	previous, existed := translationKey.Translations[language]
+	translationKey.Translations[language] = value
+
+	return previous, existed, nil
+}
+
+// RemoveKeyTranslation removes translation of key to language in memory if it still has given value
+func (r *Repository) RemoveKeyTranslation(ctx context.Context, key string, language string, value string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	translationKey, exists := r.keys[key]
+	if !exists {
+		return false, nil
+	}
+
+	current, existed := translationKey.Translations[language]
+	if !existed || current != value {
+		return false, nil
+	}
+	delete(translationKey.Translations, language)
+
+	return true, nil
+}
+
+// GetAllTranslationKeys gets all translation keys from memory
+func (r *Repository) GetAllTranslationKeys(ctx context.Context) ([]*translation.TranslationKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var translationKeys []*translation.TranslationKey
+	for _, key := range r.keys {
+		translationKeys = append(translationKeys, cloneKey(key))
+	}
+
+	return translationKeys, nil
+}
+
+// KeyExists checks key existence in memory
+func (r *Repository) KeyExists(ctx context.Context, key string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, exists := r.keys[key]
+	return exists, nil
+}
+
+// UpdateTranslationKeyValue updates translation key value and clears existing translations
+func (r *Repository) UpdateTranslationKeyValue(ctx context.Context, key string, newValue string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existingKey, exists := r.keys[key]
+	if !exists {
+		return fmt.Errorf("failed to get existing translation key: translation key not found")
+	}
+
+	existingKey.Value = newValue
+	existingKey.Translations = make(map[string]string)
+	return nil
+}
+
+// DeleteTranslationKey deletes translation key and all its translations from memory
+func (r *Repository) DeleteTranslationKey(ctx context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.keys[key]; !exists {
+		return fmt.Errorf("translation key not found")
+	}
+
+	delete(r.keys, key)
+	return nil
+}
+
+// GetIncompleteRequests gets all requests that are not in a final status
+func (r *Repository) GetIncompleteRequests(ctx context.Context) ([]*translation.TranslationRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var incompleteRequests []*translation.TranslationRequest
+	for _, request := range r.requests {
+		if !request.Status.IsFinal() {
+			incompleteRequests = append(incompleteRequests, cloneRequest(request))
+		}
+	}
+
+	return incompleteRequests, nil
+}
+
+// SaveKeyResults saves outcomes of request keys in memory
+func (r *Repository) SaveKeyResults(ctx context.Context, requestID uuid.UUID, results []*translation.KeyResult) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.results[requestID] == nil {
+		r.results[requestID] = make(map[string]*translation.KeyResult)
+	}
+	for _, result := range results {
+		clone := *result
+		r.results[requestID][result.Language+":"+result.Key] = &clone
+	}
+
+	return nil
+}
+
+// DeleteKeyResults deletes outcomes of request keys from memory
+func (r *Repository) DeleteKeyResults(ctx context.Context, requestID uuid.UUID, results []*translation.KeyResult) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, result := range results {
+		delete(r.results[requestID], result.Language+":"+result.Key)
+	}
+
+	return nil
+}
+
+// GetKeyResults gets outcomes of request keys from memory
+func (r *Repository) GetKeyResults(ctx context.Context, requestID uuid.UUID) ([]*translation.KeyResult, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var results []*translation.KeyResult
+	for _, result := range r.results[requestID] {
+		clone := *result
+		results = append(results, &clone)
+	}
+
+	return results, nil
+}
+
+// SaveChunkPlan replaces chunk plan of request in memory
+func (r *Repository) SaveChunkPlan(ctx context.Context, requestID uuid.UUID, planID uuid.UUID, count int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.chunks[requestID] = &chunkPlan{id: planID, total: count, done: make(map[int]bool)}
+	return nil
+}
+
+// CompleteChunk marks chunk of plan as done in memory
+func (r *Repository) CompleteChunk(ctx context.Context, requestID uuid.UUID, planID uuid.UUID, chunk int) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	plan, exists := r.chunks[requestID]
+	if !exists || plan.id != planID || plan.done[chunk] {
+		return false, nil
+	}
+
+	plan.done[chunk] = true
+	return len(plan.done) == plan.total, nil
+}
+
+// GetChunkPlan gets ID of current chunk plan of request from memory
+func (r *Repository) GetChunkPlan(ctx context.Context, requestID uuid.UUID) (*uuid.UUID, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	plan, exists := r.chunks[requestID]
+	if !exists {
+		return nil, nil
+	}
+
+	planID := plan.id
+	return &planID, nil
+}
+
+// ScheduleChunks adds chunks to project backlog in memory
+func (r *Repository) ScheduleChunks(ctx context.Context, project string, chunks []*translation.ScheduledChunk) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	backlog := r.backlogs[project]
+	for _, chunk := range chunks {
+		r.backlogSequence++
+		scheduled := *chunk
+		backlog = append(backlog, backlogEntry{chunk: &scheduled, sequence: r.backlogSequence})
+	}
+
+	sort.SliceStable(backlog, func(i, j int) bool {
+		if backlog[i].chunk.Priority != backlog[j].chunk.Priority {
+			return backlog[i].chunk.Priority > backlog[j].chunk.Priority
+		}
+		return backlog[i].sequence < backlog[j].sequence
+	})
+	r.backlogs[project] = backlog
+
+	return nil
+}
+
+// ReleaseChunks moves chunks from project backlog to in flight in memory
+func (r *Repository) ReleaseChunks(ctx context.Context, project string, limit int, leaseUntil time.Time) ([]*translation.ScheduledChunk, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	inFlight := r.inFlight[project]
+	if inFlight == nil {
+		inFlight = make(map[string]time.Time)
+		r.inFlight[project] = inFlight
+	}
+
+	now := time.Now()
+	for id, lease := range inFlight {
+		if !lease.After(now) {
+			delete(inFlight, id)
+		}
+	}
+
+	backlog := r.backlogs[project]
+	var released []*translation.ScheduledChunk
+	for len(backlog) > 0 && len(inFlight) < limit {
+		chunk := backlog[0].chunk
+		backlog = backlog[1:]
+		inFlight[chunk.ID()] = leaseUntil
+		released = append(released, chunk)
+	}
+
+	if len(backlog) == 0 {
+		delete(r.backlogs, project)
+	} else {
+		r.backlogs[project] = backlog
+	}
+
+	return released, nil
+}
+
+// FinishChunk removes chunk from project chunks in flight in memory
+func (r *Repository) FinishChunk(ctx context.Context, project string, chunkID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.inFlight[project], chunkID)
+	return nil
+}
+
+// CountScheduledChunks counts chunks of request in project backlogs in memory
+func (r *Repository) CountScheduledChunks(ctx context.Context, requestID uuid.UUID) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+	for _, backlog := range r.backlogs {
+		for _, entry := range backlog {
+			if entry.chunk.RequestID == requestID {
+				count++
+			}
+		}
+	}
+
+	return count, nil
+}
+
+// ExtendRequestLease extends lease of request in memory
+func (r *Repository) ExtendRequestLease(ctx context.Context, requestID uuid.UUID, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if until.After(r.leases[requestID]) {
+		r.leases[requestID] = until
+	}
+	return nil
+}
+
+// GetRequestLease gets lease expiry of request from memory
+func (r *Repository) GetRequestLease(ctx context.Context, requestID uuid.UUID) (*time.Time, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	until, exists := r.leases[requestID]
+	if !exists {
+		return nil, nil
+	}
+	return &until, nil
+}
+
+// GetExpiredRequestLeases gets requests with expired leases from memory
+func (r *Repository) GetExpiredRequestLeases(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var expired []uuid.UUID
+	for id, until := range r.leases {
+		if !until.After(now) {
+			expired = append(expired, id)
+		}
+	}
+
+	return expired, nil
+}
+
+// RemoveRequestLease removes lease of request from memory
+func (r *Repository) RemoveRequestLease(ctx context.Context, requestID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.leases, requestID)
+	return nil
+}
+
+// requestClaim represents name held by request until it expires
+type requestClaim struct {
+	requestID uuid.UUID
+	expiresAt time.Time
+}
+
+// ClaimRequestKey claims name for request in memory unless another request holds it
+func (r *Repository) ClaimRequestKey(ctx context.Context, name string, requestID uuid.UUID, ttl time.Duration) (uuid.UUID, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if claim, exists := r.claims[name]; exists && claim.expiresAt.After(time.Now()) {
+		return claim.requestID, false, nil
+	}
+
+	r.claims[name] = requestClaim{requestID: requestID, expiresAt: time.Now().Add(ttl)}
+	return requestID, true, nil
+}
+
+// ReleaseRequestKey releases name in memory if it is still held by request
+func (r *Repository) ReleaseRequestKey(ctx context.Context, name string, requestID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if claim, exists := r.claims[name]; exists && claim.requestID == requestID {
+		delete(r.claims, name)
+	}
+	return nil
+}
+
+// idempotentResponse represents response saved for idempotency claim until it expires
+type idempotentResponse struct {
+	response  translation.IdempotentResponse
+	expiresAt time.Time
+}
+
+// SaveIdempotentResponse saves response for idempotency claim name in memory
+func (r *Repository) SaveIdempotentResponse(ctx context.Context, name string, response *translation.IdempotentResponse, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	saved := *response
+	saved.Body = slices.Clone(response.Body)
+	r.responses[name] = idempotentResponse{response: saved, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+
+// GetIdempotentResponse gets response saved for idempotency claim name from memory
+func (r *Repository) GetIdempotentResponse(ctx context.Context, name string) (*translation.IdempotentResponse, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	saved, exists := r.responses[name]
+	if !exists || !saved.expiresAt.After(time.Now()) {
+		return nil, nil
+	}
+	response := saved.response
+	response.Body = slices.Clone(saved.response.Body)
+	return &response, nil
+}
+
+// GetScheduledProjects gets projects with chunks in backlog from memory
+func (r *Repository) GetScheduledProjects(ctx context.Context) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	projects := make([]string, 0, len(r.backlogs))
+	for project := range r.backlogs {
+		projects = append(projects, project)
+	}
+	sort.Strings(projects)
+
+	return projects, nil
+}
+
+// AppendHistory appends entries to translation key history in memory
+func (r *Repository) AppendHistory(ctx context.Context, entries []*translation.HistoryEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, entry := range entries {
+		entry.Version = int64(len(r.history[entry.Key]) + 1)
+		history := *entry
+		r.history[entry.Key] = append(r.history[entry.Key], &history)
+
+		entry.Sequence = int64(len(r.changes) + 1)
+		change := *entry
+		r.changes = append(r.changes, &change)
+	}
+
+	return nil
+}
+
+// GetKeyHistory gets history of translation key from memory
+func (r *Repository) GetKeyHistory(ctx context.Context, key string) ([]*translation.HistoryEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var history []*translation.HistoryEntry
+	for _, entry := range r.history[key] {
+		clone := *entry
+		history = append(history, &clone)
+	}
+
+	return history, nil
+}
+
+// GetHistoryKeys gets all keys that have history from memory
+func (r *Repository) GetHistoryKeys(ctx context.Context) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys := make([]string, 0, len(r.history))
+	for key := range r.history {
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// GetChangesSince gets changes recorded after sequence from memory
+func (r *Repository) GetChangesSince(ctx context.Context, sequence int64) ([]*translation.HistoryEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var changes []*translation.HistoryEntry
+	for _, entry := range r.changes {
+		if entry.Sequence > sequence {
+			clone := *entry
+			changes = append(changes, &clone)
+		}
+	}
+
+	return changes, nil
+}
+
+// GetSequenceAt gets sequence of the last change recorded at or before given time from memory
+func (r *Repository) GetSequenceAt(ctx context.Context, at time.Time) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var sequence int64
+	for _, entry := range r.changes {
+		if entry.CreatedAt.After(at) {
+			break
+		}
+		sequence = entry.Sequence
+	}
+
+	return sequence, nil
+}
+
+// SaveRelease saves release in memory, failing if it already exists
+func (r *Repository) SaveRelease(ctx context.Context, release *translation.Release) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.releases[release.Name]; exists {
+		return fmt.Errorf("release already exists")
+	}
+
+	r.releases[release.Name] = cloneRelease(release)
+	return nil
+}
+
+// GetRelease gets release by name from memory
+func (r *Repository) GetRelease(ctx context.Context, name string) (*translation.Release, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	release, exists := r.releases[name]
+	if !exists {
+		return nil, fmt.Errorf("release not found")
+	}
+
+	return cloneRelease(release), nil
+}
+
+// GetAllReleases gets all releases from memory
+func (r *Repository) GetAllReleases(ctx context.Context) ([]*translation.Release, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var releases []*translation.Release
+	for _, release := range r.releases {
+		releases = append(releases, cloneRelease(release))
+	}
+
+	return releases, nil
+}
+
+// PublishRelease marks release as published in memory
+func (r *Repository) PublishRelease(ctx context.Context, name string, publishedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	release, exists := r.releases[name]
+	if !exists {
+		return fmt.Errorf("release not found")
+	}
+
+	release.PublishedAt = &publishedAt
+	return nil
+}
+
+// cloneRequest returns a deep copy of request so callers never share state with the store
+func cloneRequest(request *translation.TranslationRequest) *translation.TranslationRequest {
+	clone := *request
+
+	clone.SourceData = make(map[string]string, len(request.SourceData))
+	for key, value := range request.SourceData {
+		clone.SourceData[key] = value
+	}
+
+	clone.Languages = append([]string(nil), request.Languages...)
+
+	if request.CompletedAt != nil {
+		completedAt := *request.CompletedAt
+		clone.CompletedAt = &completedAt
+	}
+
+	return &clone
+}
+
+// cloneKey returns a deep copy of translation key
+func cloneKey(key *translation.TranslationKey) *translation.TranslationKey {
+	clone := *key
+
+	clone.Translations = make(map[string]string, len(key.Translations))
+	for lang, value := range key.Translations {
+		clone.Translations[lang] = value
+	}
+
+	return &clone
+}
+
+// cloneRelease returns a deep copy of release
+func cloneRelease(release *translation.Release) *translation.Release {
+	clone := *release
+
+	clone.SourceData = make(map[string]string, len(release.SourceData))
+	for key, value := range release.SourceData {
+		clone.SourceData[key] = value
+	}
+
+	if release.PublishedAt != nil {
+		publishedAt := *release.PublishedAt
+		clone.PublishedAt = &publishedAt
+	}
+
+	clone.Translations = make(map[string]map[string]string, len(release.Translations))
+	for lang, values := range release.Translations {
+		clone.Translations[lang] = make(map[string]string, len(values))
+		for key, value := range values {
+			clone.Translations[lang][key] = value
+		}
+	}
+
+	return &clone
+}
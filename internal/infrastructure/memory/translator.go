@@ -0,0 +1,99 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"translation/internal/infrastructure/openai"
+)
+
+// Translator represents deterministic fake translator that never calls external services
+type Translator struct {
+	mu        sync.Mutex
+	calls     int
+	active    int
+	maxActive int
+	delay     time.Duration
+	fail      map[string]error
+}
+
+// NewTranslator creates a new fake translator instance
+func NewTranslator() *Translator {
+	return &Translator{
+		fail: make(map[string]error),
+	}
+}
+
+// Translate returns text prefixed with target language, e.g. "[es] Hello"
+func (t *Translator) Translate(ctx context.Context, req *openai.TranslationRequest) (*openai.TranslationResponse, error) {
+	t.mu.Lock()
+	t.calls++
+	t.active++
+	if t.active > t.maxActive {
+		t.maxActive = t.active
+	}
+	delay := t.delay
+	err := t.fail[req.ToLang]
+	t.mu.Unlock()
+
+	// Like a real API call, a translation in progress is aborted when ctx is done
+	select {
+	case <-time.After(delay):
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	t.mu.Lock()
+	t.active--
+	t.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &openai.TranslationResponse{
+		TranslatedText: FakeTranslation(req.Text, req.ToLang),
+		Confidence:     1.0,
+		Provider:       "fake",
+		Model:          "deterministic",
+	}, nil
+}
+
+// FailLanguage makes all translations to given language fail with error
+func (t *Translator) FailLanguage(lang string, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.fail[lang] = err
+}
+
+// SetDelay makes every translation take given time, so concurrent calls overlap
+func (t *Translator) SetDelay(delay time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.delay = delay
+}
+
+// MaxConcurrentCalls returns the highest number of translation calls that were in progress at once
+func (t *Translator) MaxConcurrentCalls() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.maxActive
+}
+
+// Calls returns number of translation calls made
+func (t *Translator) Calls() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.calls
+}
+
+// FakeTranslation returns translation the fake translator produces for text
+func FakeTranslation(text, lang string) string {
+	return fmt.Sprintf("[%s] %s", lang, text)
+}
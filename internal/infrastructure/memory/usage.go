@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"translation/internal/domain/translation"
+)
+
+// usageCount represents counter value until its window ends
+type usageCount struct {
+	value     int64
+	expiresAt time.Time
+}
+
+// UsageCounter represents in-process usage counters with the same contract as Redis usage counter, used
+// in tests
+type UsageCounter struct {
+	mu       sync.Mutex
+	counters map[string]usageCount
+}
+
+// NewUsageCounter creates a new in-process usage counter
+func NewUsageCounter() *UsageCounter {
+	return &UsageCounter{
+		counters: make(map[string]usageCount),
+	}
+}
+
+// current returns counter value, 0 once its window ended
+func (u *UsageCounter) current(key string) int64 {
+	counter, ok := u.counters[key]
+	if !ok {
+		return 0
+	}
+	if !counter.expiresAt.After(time.Now()) {
+		delete(u.counters, key)
+		return 0
+	}
+	return counter.value
+}
+
+// AddUsage adds increments to their counters atomically, unless one would exceed its limit
+func (u *UsageCounter) AddUsage(ctx context.Context, increments []translation.UsageIncrement) ([]int64, bool, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	values := make([]int64, len(increments))
+	allowed := true
+	for i, increment := range increments {
+		values[i] = u.current(increment.Key)
+		if increment.Limit > 0 && values[i]+increment.Amount > increment.Limit {
+			allowed = false
+		}
+	}
+	if !allowed {
+		return values, false, nil
+	}
+
+	for i, increment := range increments {
+		values[i] += increment.Amount
+		u.counters[increment.Key] = usageCount{value: values[i], expiresAt: increment.ExpiresAt}
+	}
+
+	return values, true, nil
+}
+
+// GetUsage gets counter values, 0 for counters that don't exist
+func (u *UsageCounter) GetUsage(ctx context.Context, keys []string) ([]int64, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	values := make([]int64, len(keys))
+	for i, key := range keys {
+		values[i] = u.current(key)
+	}
+
+	return values, nil
+}
@@ -0,0 +1,147 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+)
+
+// SaveWebhook saves webhook in memory
+func (r *Repository) SaveWebhook(ctx context.Context, webhook *translation.Webhook) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	clone := *webhook
+	clone.Events = append([]translation.WebhookEvent(nil), webhook.Events...)
+	r.webhooks[webhook.ID] = &clone
+	return nil
+}
+
+// GetWebhook gets webhook by ID from memory
+func (r *Repository) GetWebhook(ctx context.Context, id uuid.UUID) (*translation.Webhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	webhook, exists := r.webhooks[id]
+	if !exists {
+		return nil, fmt.Errorf("webhook not found")
+	}
+
+	clone := *webhook
+	return &clone, nil
+}
+
+// GetWebhooks gets all webhooks from memory
+func (r *Repository) GetWebhooks(ctx context.Context) ([]*translation.Webhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var webhooks []*translation.Webhook
+	for _, webhook := range r.webhooks {
+		clone := *webhook
+		webhooks = append(webhooks, &clone)
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook deletes webhook from memory
+func (r *Repository) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.webhooks, id)
+	return nil
+}
+
+// SaveDelivery saves webhook delivery in memory and updates its schedule
+func (r *Repository) SaveDelivery(ctx context.Context, delivery *translation.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deliveries[delivery.ID] = cloneDelivery(delivery)
+	if delivery.NextAttemptAt != nil {
+		r.schedule[delivery.ID] = *delivery.NextAttemptAt
+	} else {
+		delete(r.schedule, delivery.ID)
+	}
+
+	return nil
+}
+
+// GetDelivery gets webhook delivery by ID from memory
+func (r *Repository) GetDelivery(ctx context.Context, id uuid.UUID) (*translation.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	delivery, exists := r.deliveries[id]
+	if !exists {
+		return nil, fmt.Errorf("delivery not found")
+	}
+
+	return cloneDelivery(delivery), nil
+}
+
+// GetDeliveries gets deliveries of request and/or webhook from memory
+func (r *Repository) GetDeliveries(ctx context.Context, requestID *uuid.UUID, webhookID *uuid.UUID) ([]*translation.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var deliveries []*translation.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if requestID != nil && delivery.RequestID != *requestID {
+			continue
+		}
+		if webhookID != nil && (delivery.WebhookID == nil || *delivery.WebhookID != *webhookID) {
+			continue
+		}
+		deliveries = append(deliveries, cloneDelivery(delivery))
+	}
+
+	return deliveries, nil
+}
+
+// ClaimDueDeliveries unschedules and returns deliveries due at given time from memory
+func (r *Repository) ClaimDueDeliveries(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var due []uuid.UUID
+	for id, at := range r.schedule {
+		if !at.After(now) {
+			due = append(due, id)
+		}
+	}
+
+	// Earliest deliveries first, like a sorted set
+	sort.Slice(due, func(i, j int) bool {
+		return r.schedule[due[i]].Before(r.schedule[due[j]])
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	for _, id := range due {
+		delete(r.schedule, id)
+	}
+
+	return due, nil
+}
+
+// cloneDelivery returns deep copy of webhook delivery
+func cloneDelivery(delivery *translation.WebhookDelivery) *translation.WebhookDelivery {
+	clone := *delivery
+	clone.Payload = append([]byte(nil), delivery.Payload...)
+	clone.Attempts = make([]*translation.DeliveryAttempt, len(delivery.Attempts))
+	for i, attempt := range delivery.Attempts {
+		attemptClone := *attempt
+		clone.Attempts[i] = &attemptClone
+	}
+
+	return &clone
+}
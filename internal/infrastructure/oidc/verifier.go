@@ -0,0 +1,371 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	// Register hashes of supported algorithms
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"translation/internal/domain/translation"
+)
+
+const (
+	// clockSkew is tolerated difference between clocks of identity provider and service
+	clockSkew = time.Minute
+	// minRefreshInterval limits how often keys are fetched again for tokens signed with unknown keys
+	minRefreshInterval = time.Minute
+	// maxKeySetSize limits size of fetched key set
+	maxKeySetSize = 1 << 20
+)
+
+// algorithms maps supported signature algorithms to their hashes
+var algorithms = map[string]crypto.Hash{
+	"RS256": crypto.SHA256,
+	"RS384": crypto.SHA384,
+	"RS512": crypto.SHA512,
+	"ES256": crypto.SHA256,
+	"ES384": crypto.SHA384,
+	"ES512": crypto.SHA512,
+}
+
+// curveSizes maps elliptic curve algorithms to sizes of curves they sign with
+var curveSizes = map[string]int{
+	"ES256": 256,
+	"ES384": 384,
+	"ES512": 521,
+}
+
+// Options configures validation of tokens and mapping of their claims to users
+type Options struct {
+	// Issuer tokens must have in iss claim, not checked when empty
+	Issuer string
+	// Audience tokens must have in aud claim, not checked when empty
+	Audience string
+	// RolesClaim holds roles granted in all projects, dots separate nested claims
+	RolesClaim string
+	// ProjectsClaim holds roles granted per project, as object of project to roles or list of
+	// "project:role" strings, dots separate nested claims
+	ProjectsClaim string
+	// RefreshInterval is how often keys are loaded again
+	RefreshInterval time.Duration
+}
+
+// Verifier represents service validating JSON Web Tokens of users against JSON Web Key Set
+type Verifier struct {
+	load    func(ctx context.Context) ([]byte, error)
+	options Options
+
+	mu       sync.Mutex
+	keys     []verificationKey
+	loadedAt time.Time
+}
+
+// NewVerifier creates verifier of tokens signed with keys from JWKS URL or file, keys are loaded right away
+func NewVerifier(jwksURL, jwksFile string, options Options) (*Verifier, error) {
+	v := &Verifier{options: options}
+
+	switch {
+	case jwksFile != "":
+		v.load = func(ctx context.Context) ([]byte, error) {
+			return os.ReadFile(jwksFile)
+		}
+	case jwksURL != "":
+		client := &http.Client{Timeout: 10 * time.Second}
+		v.load = func(ctx context.Context) ([]byte, error) {
+			return fetchKeySet(ctx, client, jwksURL)
+		}
+	default:
+		return nil, fmt.Errorf("JWKS URL or file is required")
+	}
+
+	if err := v.refresh(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// fetchKeySet gets key set from URL
+func fetchKeySet(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxKeySetSize))
+}
+
+// refresh loads keys again, must be called with mu held or before verifier is shared
+func (v *Verifier) refresh(ctx context.Context) error {
+	data, err := v.load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load JWKS: %w", err)
+	}
+
+	keys, err := parseKeySet(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	v.keys = keys
+	v.loadedAt = time.Now()
+	return nil
+}
+
+// keysFor returns keys that may have signed token with key ID. Keys are loaded again when they are
+// due for refresh, or when no key has the ID so identity provider may have rotated keys.
+func (v *Verifier) keysFor(ctx context.Context, kid string) []verificationKey {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	age := time.Since(v.loadedAt)
+	if age >= v.options.RefreshInterval || (age >= minRefreshInterval && !hasKey(v.keys, kid)) {
+		if err := v.refresh(ctx); err != nil {
+			// Keys loaded before stay usable while identity provider is unavailable
+			log.Printf("Failed to refresh JWKS: %v", err)
+		}
+	}
+
+	var keys []verificationKey
+	for _, key := range v.keys {
+		if kid == "" || key.id == kid {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// hasKey reports whether key set has key with ID, tokens without key ID may use any key
+func hasKey(keys []verificationKey, kid string) bool {
+	if kid == "" {
+		return true
+	}
+	for _, key := range keys {
+		if key.id == kid {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthenticateToken validates token and returns user it was issued to
+func (v *Verifier) AuthenticateToken(ctx context.Context, token string) (*translation.User, error) {
+	claims, err := v.verify(ctx, token)
+	if err != nil {
+		log.Printf("Rejected token: %v", err)
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	return v.user(claims), nil
+}
+
+// verify checks token signature and registered claims and returns its claims
+func (v *Verifier) verify(ctx context.Context, token string) (map[string]any, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("malformed header: %w", err)
+	}
+
+	hash, ok := algorithms[header.Alg]
+	if !ok {
+		return nil, fmt.Errorf("unsupported algorithm %q", header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed signature: %w", err)
+	}
+
+	keys := v.keysFor(ctx, header.Kid)
+
+	digest := hash.New()
+	digest.Write([]byte(parts[0] + "." + parts[1]))
+	sum := digest.Sum(nil)
+
+	verified := false
+	for _, key := range keys {
+		if key.alg != "" && key.alg != header.Alg {
+			continue
+		}
+		if verifySignature(key.key, header.Alg, hash, sum, signature) {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, fmt.Errorf("signature doesn't match any key")
+	}
+
+	var claims map[string]any
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("malformed claims: %w", err)
+	}
+
+	if err := v.validateClaims(claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// verifySignature checks signature of digest with RSA or elliptic curve key matching algorithm
+func verifySignature(key crypto.PublicKey, alg string, hash crypto.Hash, digest, signature []byte) bool {
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		return strings.HasPrefix(alg, "RS") && rsa.VerifyPKCS1v15(key, hash, digest, signature) == nil
+	case *ecdsa.PublicKey:
+		// Signature is R and S concatenated, each as long as the curve order
+		bits := key.Curve.Params().BitSize
+		size := (bits + 7) / 8
+		if curveSizes[alg] != bits || len(signature) != 2*size {
+			return false
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		return ecdsa.Verify(key, digest, r, s)
+	}
+	return false
+}
+
+// validateClaims checks expiry, not-before time, issuer and audience of token
+func (v *Verifier) validateClaims(claims map[string]any) error {
+	now := time.Now()
+
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return fmt.Errorf("token has no expiry")
+	}
+	if now.After(time.Unix(int64(exp), 0).Add(clockSkew)) {
+		return fmt.Errorf("token expired")
+	}
+
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(clockSkew).Before(time.Unix(int64(nbf), 0)) {
+		return fmt.Errorf("token is not valid yet")
+	}
+
+	if v.options.Issuer != "" && claims["iss"] != v.options.Issuer {
+		return fmt.Errorf("unexpected issuer %v", claims["iss"])
+	}
+
+	if v.options.Audience != "" && !slices.Contains(claimStrings(claims["aud"]), v.options.Audience) {
+		return fmt.Errorf("unexpected audience %v", claims["aud"])
+	}
+
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return fmt.Errorf("token has no subject")
+	}
+
+	return nil
+}
+
+// user maps claims to user with roles and project memberships
+func (v *Verifier) user(claims map[string]any) *translation.User {
+	user := &translation.User{
+		Memberships: make(map[string][]translation.Role),
+	}
+	user.Subject, _ = claims["sub"].(string)
+	user.Email, _ = claims["email"].(string)
+	user.Name, _ = claims["name"].(string)
+
+	for _, role := range claimStrings(claim(claims, v.options.RolesClaim)) {
+		user.Roles = append(user.Roles, translation.Role(role))
+	}
+
+	switch projects := claim(claims, v.options.ProjectsClaim).(type) {
+	case map[string]any:
+		for project, roles := range projects {
+			for _, role := range claimStrings(roles) {
+				user.Memberships[project] = append(user.Memberships[project], translation.Role(role))
+			}
+		}
+	case []any:
+		for _, membership := range claimStrings(projects) {
+			// Projects may contain colons, roles don't
+			i := strings.LastIndex(membership, ":")
+			if i <= 0 {
+				continue
+			}
+			project, role := membership[:i], membership[i+1:]
+			user.Memberships[project] = append(user.Memberships[project], translation.Role(role))
+		}
+	}
+
+	return user
+}
+
+// decodeSegment decodes unpadded base64url JSON segment of token
+func decodeSegment(segment string, out any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+// claim returns claim at path, dots separate names of nested claims
+func claim(claims map[string]any, path string) any {
+	if path == "" {
+		return nil
+	}
+
+	var value any = claims
+	for _, name := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+	return value
+}
+
+// claimStrings returns strings of claim holding a string or a list of strings
+func claimStrings(value any) []string {
+	switch value := value.(type) {
+	case string:
+		return []string{value}
+	case []any:
+		var items []string
+		for _, item := range value {
+			if item, ok := item.(string); ok {
+				items = append(items, item)
+			}
+		}
+		return items
+	}
+	return nil
+}
@@ -32,6 +32,8 @@ type TranslationRequest struct {
 type TranslationResponse struct {
 	TranslatedText string  `json:"translated_text"`
 	Confidence     float64 `json:"confidence"`
+	Provider       string  `json:"provider"`
+	Model          string  `json:"model"`
 }
 
 // Translate translates text using OpenAI
@@ -70,6 +72,8 @@ func (s *Service) Translate(ctx context.Context, req *TranslationRequest) (*Tran
 	return &TranslationResponse{
 		TranslatedText: translatedText,
 		Confidence:     0.9, // OpenAI doesn't provide confidence score, use fixed value
+		Provider:       "openai",
+		Model:          resp.Model,
 	}, nil
 }
 
@@ -0,0 +1,301 @@
+package rabbitmq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Reconnect backoff limits and time to wait for broker to confirm a published message
+const (
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+	publishTimeout    = 10 * time.Second
+)
+
+// errServiceClosed is returned by operations started after service was closed
+var errServiceClosed = errors.New("RabbitMQ service is closed")
+
+// dialer connects to RabbitMQ at URL
+type dialer func(url string) (connection, error)
+
+// connection is connection to RabbitMQ, implemented by amqpConnection
+type connection interface {
+	Channel() (channel, error)
+	NotifyClose(receiver chan *amqp.Error) chan *amqp.Error
+	IsClosed() bool
+	Close() error
+}
+
+// channel is channel of connection to RabbitMQ with operations the service uses, implemented by amqpChannel
+type channel interface {
+	QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error)
+	QueueDeclarePassive(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error)
+	QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error
+	QueueUnbind(name, key, exchange string, args amqp.Table) error
+	QueueDelete(name string, ifUnused, ifEmpty, noWait bool) (int, error)
+	QueuePurge(name string, noWait bool) (int, error)
+	ExchangeDeclare(name, kind string, durable, autoDelete, internal, noWait bool, args amqp.Table) error
+	Confirm(noWait bool) error
+	Qos(prefetchCount, prefetchSize int, global bool) error
+	Consume(queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error)
+	Get(queue string, autoAck bool) (amqp.Delivery, bool, error)
+	// PublishDeferred publishes message on channel in confirm mode, returning confirmation the broker sends for it
+	PublishDeferred(ctx context.Context, exchange, key string, msg amqp.Publishing) (confirmation, error)
+	NotifyClose(receiver chan *amqp.Error) chan *amqp.Error
+	IsClosed() bool
+	Close() error
+}
+
+// confirmation is publisher confirm of one published message
+type confirmation interface {
+	WaitContext(ctx context.Context) (bool, error)
+}
+
+// amqpConnection is connection to RabbitMQ over AMQP
+type amqpConnection struct {
+	*amqp.Connection
+}
+
+// dialAMQP connects to RabbitMQ at URL over AMQP
+func dialAMQP(url string) (connection, error) {
+	conn, err := amqp.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+	return amqpConnection{conn}, nil
+}
+
+// Channel opens channel on connection
+func (c amqpConnection) Channel() (channel, error) {
+	ch, err := c.Connection.Channel()
+	if err != nil {
+		return nil, err
+	}
+	return amqpChannel{ch}, nil
+}
+
+// amqpChannel is channel of AMQP connection
+type amqpChannel struct {
+	*amqp.Channel
+}
+
+// PublishDeferred publishes message on channel in confirm mode, returning confirmation the broker sends for it
+func (c amqpChannel) PublishDeferred(ctx context.Context, exchange, key string, msg amqp.Publishing) (confirmation, error) {
+	deferred, err := c.PublishWithDeferredConfirmWithContext(ctx, exchange, key, false, false, msg)
+	if err != nil {
+		return nil, err
+	}
+	return deferred, nil
+}
+
+// dial connects to RabbitMQ, declares topology and opens channel in confirm mode used for publishing
+// and dead-letter queue operations
+func (s *Service) dial() (connection, channel, error) {
+	conn, err := s.dialer(s.url)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to RabbitMQ: %w", err)
+	}
+
+	ch, err := s.openChannel(conn)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	migrateLegacyQueues(conn, s.queue)
+
+	return conn, ch, nil
+}
+
+// openChannel opens publishing channel on connection, declaring topology on it
+func (s *Service) openChannel(conn connection) (channel, error) {
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open channel: %w", err)
+	}
+
+	if err := declareTopology(ch, s.queue); err != nil {
+		ch.Close()
+		return nil, err
+	}
+
+	// Broker acknowledges every published message once it has taken responsibility for it
+	if err := ch.Confirm(false); err != nil {
+		ch.Close()
+		return nil, fmt.Errorf("failed to enable publisher confirms: %w", err)
+	}
+
+	return ch, nil
+}
+
+// setConnected makes connection and channel current and wakes up operations waiting for them
+func (s *Service) setConnected(conn connection, ch channel) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.conn = conn
+	s.channel = ch
+	if !s.connected {
+		s.connected = true
+		close(s.ready)
+	}
+}
+
+// setDisconnected makes operations wait until connection is restored
+func (s *Service) setDisconnected() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.connected {
+		s.connected = false
+		s.ready = make(chan struct{})
+	}
+}
+
+// current returns current connection and channel, waiting for reconnect until ctx is done
+func (s *Service) current(ctx context.Context) (connection, channel, error) {
+	for {
+		s.mu.RLock()
+		conn, ch, connected, ready := s.conn, s.channel, s.connected, s.ready
+		s.mu.RUnlock()
+
+		if connected {
+			return conn, ch, nil
+		}
+
+		select {
+		case <-ready:
+		case <-s.done:
+			return nil, nil, errServiceClosed
+		case <-ctx.Done():
+			return nil, nil, fmt.Errorf("not connected to RabbitMQ: %w", ctx.Err())
+		}
+	}
+}
+
+// supervise watches current connection and channel and restores them once they are closed
+func (s *Service) supervise() {
+	for {
+		s.mu.RLock()
+		conn, ch := s.conn, s.channel
+		s.mu.RUnlock()
+
+		// Close notifications are sent synchronously, so channels are buffered
+		connClosed := conn.NotifyClose(make(chan *amqp.Error, 1))
+		chClosed := ch.NotifyClose(make(chan *amqp.Error, 1))
+
+		select {
+		case <-s.done:
+			return
+		case err := <-connClosed:
+			if s.isClosed() {
+				return
+			}
+			log.Printf("RabbitMQ connection lost: %v", err)
+			if !s.reconnect() {
+				return
+			}
+		case err := <-chClosed:
+			if s.isClosed() {
+				return
+			}
+			log.Printf("RabbitMQ channel closed: %v", err)
+			if !s.reopenChannel(conn) && !s.reconnect() {
+				return
+			}
+		}
+	}
+}
+
+// reopenChannel replaces closed channel when its connection is still open, reports success
+func (s *Service) reopenChannel(conn connection) bool {
+	if conn.IsClosed() {
+		return false
+	}
+
+	ch, err := s.openChannel(conn)
+	if err != nil {
+		log.Printf("Failed to reopen RabbitMQ channel: %v", err)
+		return false
+	}
+
+	s.setConnected(conn, ch)
+	log.Printf("RabbitMQ channel reopened")
+	return true
+}
+
+// reconnect dials RabbitMQ with exponential backoff until it succeeds or service is closed, reports success
+func (s *Service) reconnect() bool {
+	s.setDisconnected()
+
+	s.mu.RLock()
+	conn := s.conn
+	s.mu.RUnlock()
+	conn.Close()
+
+	delay := minReconnectDelay
+	for {
+		select {
+		case <-s.done:
+			return false
+		case <-time.After(delay):
+		}
+
+		conn, ch, err := s.dial()
+		if err != nil {
+			log.Printf("Failed to reconnect to RabbitMQ, retrying in %s: %v", delay, err)
+			delay = min(delay*2, maxReconnectDelay)
+			continue
+		}
+
+		s.setConnected(conn, ch)
+		log.Printf("Reconnected to RabbitMQ")
+		return true
+	}
+}
+
+// isClosed reports whether service was closed
+func (s *Service) isClosed() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// publish publishes message and waits until the broker confirms it
+func (s *Service) publish(ctx context.Context, exchange, key string, msg amqp.Publishing) error {
+	ctx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+
+	_, ch, err := s.current(ctx)
+	if err != nil {
+		return err
+	}
+
+	return publishConfirmed(ctx, ch, exchange, key, msg)
+}
+
+// publishConfirmed publishes message on channel in confirm mode and waits until the broker confirms it
+func publishConfirmed(ctx context.Context, ch channel, exchange, key string, msg amqp.Publishing) error {
+	confirmation, err := ch.PublishDeferred(ctx, exchange, key, msg)
+	if err != nil {
+		return err
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to wait for publisher confirm: %w", err)
+	}
+	if !acked {
+		return fmt.Errorf("message was not acknowledged by RabbitMQ")
+	}
+
+	return nil
+}
@@ -0,0 +1,433 @@
+package rabbitmq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Publisher confirm modes of fake broker
+const (
+	confirmAck = iota
+	confirmNack
+	confirmNever
+)
+
+// fakeBroker stands in for RabbitMQ: it records connections and published messages, hands out deliveries
+// of registered consumers and lets tests drop connections
+type fakeBroker struct {
+	mu        sync.Mutex
+	conns     []*fakeConnection
+	published []amqp.Publishing
+	confirm   int
+	acked     int
+
+	// consumers receives deliveries channel of every registered consumer
+	consumers chan chan amqp.Delivery
+}
+
+// newFakeBroker creates fake broker acknowledging published messages
+func newFakeBroker() *fakeBroker {
+	return &fakeBroker{consumers: make(chan chan amqp.Delivery, 10)}
+}
+
+// dial connects to fake broker
+func (b *fakeBroker) dial(string) (connection, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	conn := &fakeConnection{broker: b}
+	b.conns = append(b.conns, conn)
+	return conn, nil
+}
+
+// connections returns number of dialed connections
+func (b *fakeBroker) connections() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.conns)
+}
+
+// drop closes latest connection like a broker restart does
+func (b *fakeBroker) drop() {
+	b.mu.Lock()
+	conn := b.conns[len(b.conns)-1]
+	b.mu.Unlock()
+
+	conn.shutdown(&amqp.Error{Code: amqp.ConnectionForced, Reason: "broker restarted"})
+}
+
+// setConfirm sets how published messages are confirmed
+func (b *fakeBroker) setConfirm(mode int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.confirm = mode
+}
+
+// publish records message and returns its confirmation
+func (b *fakeBroker) publish(msg amqp.Publishing) confirmation {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.published = append(b.published, msg)
+	return fakeConfirmation{mode: b.confirm}
+}
+
+// Ack acknowledges delivery
+func (b *fakeBroker) Ack(tag uint64, multiple bool) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.acked++
+	return nil
+}
+
+// Nack rejects delivery
+func (b *fakeBroker) Nack(tag uint64, multiple bool, requeue bool) error {
+	return nil
+}
+
+// Reject rejects delivery
+func (b *fakeBroker) Reject(tag uint64, requeue bool) error {
+	return nil
+}
+
+// acknowledged returns number of acknowledged deliveries
+func (b *fakeBroker) acknowledged() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.acked
+}
+
+// fakeConfirmation is publisher confirm of fake broker
+type fakeConfirmation struct {
+	mode int
+}
+
+// WaitContext waits for confirmation, which never comes in confirmNever mode
+func (c fakeConfirmation) WaitContext(ctx context.Context) (bool, error) {
+	if c.mode == confirmNever {
+		<-ctx.Done()
+		return false, ctx.Err()
+	}
+	return c.mode == confirmAck, nil
+}
+
+// fakeConnection is connection to fake broker
+type fakeConnection struct {
+	broker *fakeBroker
+
+	mu       sync.Mutex
+	closed   bool
+	notify   []chan *amqp.Error
+	channels []*fakeChannel
+}
+
+// Channel opens channel on connection
+func (c *fakeConnection) Channel() (channel, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil, amqp.ErrClosed
+	}
+	ch := &fakeChannel{broker: c.broker}
+	c.channels = append(c.channels, ch)
+	return ch, nil
+}
+
+// NotifyClose registers receiver of connection closing
+func (c *fakeConnection) NotifyClose(receiver chan *amqp.Error) chan *amqp.Error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		close(receiver)
+		return receiver
+	}
+	c.notify = append(c.notify, receiver)
+	return receiver
+}
+
+// IsClosed reports whether connection is closed
+func (c *fakeConnection) IsClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.closed
+}
+
+// Close closes connection
+func (c *fakeConnection) Close() error {
+	c.shutdown(nil)
+	return nil
+}
+
+// shutdown closes connection with its channels, notifying receivers of err unless it is nil
+func (c *fakeConnection) shutdown(err *amqp.Error) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return
+	}
+	c.closed = true
+	notify, channels := c.notify, c.channels
+	c.mu.Unlock()
+
+	for _, ch := range channels {
+		ch.shutdown(err)
+	}
+	for _, receiver := range notify {
+		if err != nil {
+			receiver <- err
+		}
+		close(receiver)
+	}
+}
+
+// fakeChannel is channel of fake connection
+type fakeChannel struct {
+	broker *fakeBroker
+
+	mu         sync.Mutex
+	closed     bool
+	notify     []chan *amqp.Error
+	deliveries []chan amqp.Delivery
+}
+
+func (c *fakeChannel) QueueDeclare(string, bool, bool, bool, bool, amqp.Table) (amqp.Queue, error) {
+	return amqp.Queue{}, nil
+}
+
+// QueueDeclarePassive fails like it does for a missing queue, no legacy queues exist
+func (c *fakeChannel) QueueDeclarePassive(name string, _, _, _, _ bool, _ amqp.Table) (amqp.Queue, error) {
+	return amqp.Queue{}, &amqp.Error{Code: amqp.NotFound, Reason: "no queue " + name}
+}
+
+func (c *fakeChannel) QueueBind(string, string, string, bool, amqp.Table) error { return nil }
+
+func (c *fakeChannel) QueueUnbind(string, string, string, amqp.Table) error { return nil }
+
+func (c *fakeChannel) QueueDelete(string, bool, bool, bool) (int, error) { return 0, nil }
+
+func (c *fakeChannel) QueuePurge(string, bool) (int, error) { return 0, nil }
+
+func (c *fakeChannel) ExchangeDeclare(string, string, bool, bool, bool, bool, amqp.Table) error {
+	return nil
+}
+
+func (c *fakeChannel) Confirm(bool) error { return nil }
+
+func (c *fakeChannel) Qos(int, int, bool) error { return nil }
+
+func (c *fakeChannel) Get(string, bool) (amqp.Delivery, bool, error) {
+	return amqp.Delivery{}, false, nil
+}
+
+// Consume registers consumer, whose deliveries are closed once channel is
+func (c *fakeChannel) Consume(string, string, bool, bool, bool, bool, amqp.Table) (<-chan amqp.Delivery, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil, amqp.ErrClosed
+	}
+	deliveries := make(chan amqp.Delivery, 10)
+	c.deliveries = append(c.deliveries, deliveries)
+	c.broker.consumers <- deliveries
+	return deliveries, nil
+}
+
+// PublishDeferred publishes message to fake broker
+func (c *fakeChannel) PublishDeferred(_ context.Context, _, _ string, msg amqp.Publishing) (confirmation, error) {
+	if c.IsClosed() {
+		return nil, amqp.ErrClosed
+	}
+	return c.broker.publish(msg), nil
+}
+
+// NotifyClose registers receiver of channel closing
+func (c *fakeChannel) NotifyClose(receiver chan *amqp.Error) chan *amqp.Error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		close(receiver)
+		return receiver
+	}
+	c.notify = append(c.notify, receiver)
+	return receiver
+}
+
+// IsClosed reports whether channel is closed
+func (c *fakeChannel) IsClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.closed
+}
+
+// Close closes channel
+func (c *fakeChannel) Close() error {
+	c.shutdown(nil)
+	return nil
+}
+
+// shutdown closes channel and its deliveries, notifying receivers of err unless it is nil
+func (c *fakeChannel) shutdown(err *amqp.Error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return
+	}
+	c.closed = true
+	for _, deliveries := range c.deliveries {
+		close(deliveries)
+	}
+	for _, receiver := range c.notify {
+		if err != nil {
+			receiver <- err
+		}
+		close(receiver)
+	}
+}
+
+// newTestService creates service connected to fake broker, closed when the test ends
+func newTestService(t *testing.T, broker *fakeBroker) *Service {
+	t.Helper()
+
+	s, err := newService("amqp://fake", "translations", 1, translation.RetryPolicy{MaxAttempts: 3, Delay: time.Second}, broker.dial)
+	if err != nil {
+		t.Fatalf("failed to create service: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+// waitFor waits until condition holds
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting until %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestPublishWaitsForReconnect(t *testing.T) {
+	broker := newFakeBroker()
+	s := newTestService(t, broker)
+
+	broker.drop()
+	waitFor(t, "service notices lost connection", func() bool {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return !s.connected
+	})
+
+	// Publishing during the outage waits for the connection to be restored
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.PublishTask(ctx, &translation.TranslationTask{RequestID: uuid.New()}); err != nil {
+		t.Fatalf("expected task to be published after reconnect, got %v", err)
+	}
+	if dialed := broker.connections(); dialed != 2 {
+		t.Errorf("expected service to reconnect once, got %d connections", dialed)
+	}
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+	if len(broker.published) != 1 {
+		t.Errorf("expected one published task, got %d", len(broker.published))
+	}
+}
+
+func TestConsumerResubscribesAfterConnectionLoss(t *testing.T) {
+	broker := newFakeBroker()
+	s := newTestService(t, broker)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	handled := make(chan uuid.UUID, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- s.ConsumeTasks(ctx, 1, func(task *translation.TranslationTask) error {
+			handled <- task.RequestID
+			return nil
+		}, func(*translation.TranslationTask, error) {})
+	}()
+
+	<-broker.consumers
+	broker.drop()
+
+	var deliveries chan amqp.Delivery
+	select {
+	case deliveries = <-broker.consumers:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected consumer to be registered again after reconnect")
+	}
+	if dialed := broker.connections(); dialed != 2 {
+		t.Errorf("expected consumer to be registered on the new connection, got %d connections", dialed)
+	}
+
+	requestID := uuid.New()
+	body, _ := json.Marshal(translation.TranslationTask{RequestID: requestID})
+	deliveries <- amqp.Delivery{Acknowledger: broker, DeliveryTag: 1, Body: body}
+	select {
+	case got := <-handled:
+		if got != requestID {
+			t.Errorf("expected task of request %s, got %s", requestID, got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected resubscribed consumer to process delivered task")
+	}
+	waitFor(t, "task is acknowledged", func() bool { return broker.acknowledged() == 1 })
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("expected consumer to stop cleanly, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected consumer to stop once ctx is done")
+	}
+}
+
+func TestPublishFailsWithoutConfirm(t *testing.T) {
+	broker := newFakeBroker()
+	s := newTestService(t, broker)
+	task := &translation.TranslationTask{RequestID: uuid.New()}
+
+	broker.setConfirm(confirmNack)
+	err := s.PublishTask(context.Background(), task)
+	if err == nil || !strings.Contains(err.Error(), "not acknowledged") {
+		t.Errorf("expected nacked task to fail publishing, got %v", err)
+	}
+
+	broker.setConfirm(confirmNever)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	started := time.Now()
+	err = s.PublishTask(ctx, task)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected unconfirmed task to fail publishing at the deadline, got %v", err)
+	}
+	if elapsed := time.Since(started); elapsed > time.Second {
+		t.Errorf("expected publishing to give up at the deadline, took %s", elapsed)
+	}
+}
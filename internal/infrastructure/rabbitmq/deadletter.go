@@ -0,0 +1,144 @@
+package rabbitmq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"translation/internal/domain/translation"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// GetDeadLetters returns up to limit dead-lettered tasks without removing them
+func (s *Service) GetDeadLetters(ctx context.Context, limit int) ([]*translation.DeadLetter, error) {
+	s.deadLetterMu.Lock()
+	defer s.deadLetterMu.Unlock()
+
+	_, ch, err := s.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := fetchDeadLetters(ch, deadLetterName(s.queue), limit)
+	// Return all fetched messages to the queue in their original order
+	for _, delivery := range deliveries {
+		delivery.Nack(false, true)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	deadLetters := make([]*translation.DeadLetter, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		deadLetters = append(deadLetters, toDeadLetter(delivery))
+	}
+
+	return deadLetters, nil
+}
+
+// ProcessDeadLetters calls handler for dead-lettered tasks with given IDs, all of them when ids is empty.
+// Tasks are removed from the queue once handler succeeds. Returns number of removed tasks.
+func (s *Service) ProcessDeadLetters(ctx context.Context, ids []string, handler func(*translation.DeadLetter) error) (int, error) {
+	s.deadLetterMu.Lock()
+	defer s.deadLetterMu.Unlock()
+
+	_, ch, err := s.current(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	queue, err := inspectQueue(ch, deadLetterName(s.queue))
+	if err != nil {
+		return 0, fmt.Errorf("failed to inspect dead-letter queue: %w", err)
+	}
+
+	deliveries, err := fetchDeadLetters(ch, queue.Name, queue.Messages)
+	if err != nil {
+		for _, delivery := range deliveries {
+			delivery.Nack(false, true)
+		}
+		return 0, err
+	}
+
+	selected := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		selected[id] = true
+	}
+
+	processed := 0
+	var handlerErr error
+	for _, delivery := range deliveries {
+		if (len(ids) > 0 && !selected[delivery.MessageId]) || handlerErr != nil {
+			delivery.Nack(false, true)
+			continue
+		}
+
+		if err := handler(toDeadLetter(delivery)); err != nil {
+			handlerErr = err
+			delivery.Nack(false, true)
+			continue
+		}
+
+		delivery.Ack(false)
+		processed++
+	}
+
+	return processed, handlerErr
+}
+
+// PurgeDeadLetters removes all dead-lettered tasks and returns their number
+func (s *Service) PurgeDeadLetters(ctx context.Context) (int, error) {
+	s.deadLetterMu.Lock()
+	defer s.deadLetterMu.Unlock()
+
+	_, ch, err := s.current(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := ch.QueuePurge(deadLetterName(s.queue), false)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge dead-letter queue: %w", err)
+	}
+
+	return count, nil
+}
+
+// fetchDeadLetters gets up to limit messages from dead-letter queue without acknowledging them,
+// so they are not delivered again until acknowledged or rejected
+func fetchDeadLetters(ch channel, queueName string, limit int) ([]amqp.Delivery, error) {
+	var deliveries []amqp.Delivery
+	for len(deliveries) < limit {
+		delivery, ok, err := ch.Get(queueName, false)
+		if err != nil {
+			return deliveries, fmt.Errorf("failed to get dead-lettered task: %w", err)
+		}
+		if !ok {
+			break
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+// toDeadLetter converts dead-lettered message to dead letter, malformed tasks have no task
+func toDeadLetter(delivery amqp.Delivery) *translation.DeadLetter {
+	deadLetter := &translation.DeadLetter{
+		ID:             delivery.MessageId,
+		Attempts:       retryCount(delivery.Headers),
+		DeadLetteredAt: delivery.Timestamp,
+	}
+
+	if lastError, ok := delivery.Headers[headerLastError].(string); ok {
+		deadLetter.Error = lastError
+	}
+
+	var task translation.TranslationTask
+	if err := json.Unmarshal(delivery.Body, &task); err == nil {
+		deadLetter.Task = &task
+	}
+
+	return deadLetter
+}
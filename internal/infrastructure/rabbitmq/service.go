@@ -3,80 +3,252 @@ package rabbitmq
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"translation/internal/domain/translation"
 
 	"github.com/google/uuid"
-	"github.com/streadway/amqp"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Message headers used to track retries of a task
+const (
+	headerRetryCount     = "x-retry-count"
+	headerLastError      = "x-last-error"
+	headerDeadLetteredAt = "x-dead-lettered-at"
 )
 
-// Service represents service for working with RabbitMQ
+// Service represents service for working with RabbitMQ. It keeps the connection alive,
+// reconnecting and re-registering consumers whenever RabbitMQ becomes unavailable.
 type Service struct {
-	conn    *amqp.Connection
-	channel *amqp.Channel
-	queue   string
+	dialer   dialer
+	url      string
+	queue    string
+	prefetch int
+	retry    translation.RetryPolicy
+
+	// mu guards current connection and channel, ready is closed while they are usable
+	mu        sync.RWMutex
+	conn      connection
+	channel   channel
+	connected bool
+	ready     chan struct{}
+
+	done      chan struct{}
+	closeOnce sync.Once
+
+	// deadLetterMu serializes operations on the dead-letter queue
+	deadLetterMu sync.Mutex
 }
 
-// TranslationTask represents translation task
-type TranslationTask struct {
-	RequestID  uuid.UUID         `json:"request_id"`
-	SourceData map[string]string `json:"source_data"`
-	Languages  []string          `json:"languages"`
+// NewService creates a new RabbitMQ service instance delivering up to prefetch unacknowledged tasks to each consumer.
+// Tasks are queued in "<queue>.priority" priority queue. Besides it, the service declares "<queue>.retry" exchange
+// routing failed tasks to "<queue>.delay" queue, where they wait for retry delay, and "<queue>.dead" queue keeping
+// tasks that failed all attempts.
+func NewService(url, queueName string, prefetch int, retry translation.RetryPolicy) (*Service, error) {
+	return newService(url, queueName, prefetch, retry, dialAMQP)
 }
 
-// NewService creates a new RabbitMQ service instance
-func NewService(url, queueName string) (*Service, error) {
-	conn, err := amqp.Dial(url)
+// newService creates RabbitMQ service connecting with dialer
+func newService(url, queueName string, prefetch int, retry translation.RetryPolicy, dialer dialer) (*Service, error) {
+	s := &Service{
+		dialer:   dialer,
+		url:      url,
+		queue:    queueName,
+		prefetch: prefetch,
+		retry:    retry,
+		ready:    make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+
+	conn, ch, err := s.dial()
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to RabbitMQ: %w", err)
+		return nil, err
 	}
 
-	ch, err := conn.Channel()
+	s.setConnected(conn, ch)
+	go s.supervise()
+
+	return s, nil
+}
+
+// taskQueueName returns name of priority queue holding tasks of task queue
+func taskQueueName(queueName string) string {
+	return queueName + ".priority"
+}
+
+// retryName returns name of retry exchange of task queue
+func retryName(queueName string) string {
+	return queueName + ".retry"
+}
+
+// delayName returns name of queue holding failed tasks of task queue until their retry
+func delayName(queueName string) string {
+	return queueName + ".delay"
+}
+
+// deadLetterName returns name of dead-letter queue of task queue
+func deadLetterName(queueName string) string {
+	return queueName + ".dead"
+}
+
+// declareTopology declares task queue, retry exchange with its delay queue and dead-letter queue. Arguments
+// of existing queues can't be changed, so queues whose arguments change get new names.
+func declareTopology(ch channel, queueName string) error {
+	_, err := ch.QueueDeclare(
+		taskQueueName(queueName), // name
+		true,                     // durable
+		false,                    // delete when unused
+		false,                    // exclusive
+		false,                    // no-wait
+		amqp.Table{
+			"x-max-priority": int64(translation.MaxPriority),
+		},
+	)
 	if err != nil {
-		conn.Close()
-		return nil, fmt.Errorf("failed to open channel: %w", err)
+		return fmt.Errorf("failed to declare queue: %w", err)
 	}
 
-	// Declare queue
+	if err := ch.ExchangeDeclare(
+		retryName(queueName), // name
+		"direct",             // kind
+		true,                 // durable
+		false,                // auto-deleted
+		false,                // internal
+		false,                // no-wait
+		nil,                  // arguments
+	); err != nil {
+		return fmt.Errorf("failed to declare retry exchange: %w", err)
+	}
+
+	// Tasks wait in delay queue until they expire and are then dead-lettered back to the task queue.
+	// Every task sets its own expiration, so retry delay can change without declaring the queue again.
 	_, err = ch.QueueDeclare(
-		queueName, // name
-		true,      // durable
-		false,     // delete when unused
-		false,     // exclusive
-		false,     // no-wait
-		nil,       // arguments
+		delayName(queueName), // name
+		true,                 // durable
+		false,                // delete when unused
+		false,                // exclusive
+		false,                // no-wait
+		amqp.Table{
+			"x-dead-letter-exchange":    "",
+			"x-dead-letter-routing-key": taskQueueName(queueName),
+		},
 	)
 	if err != nil {
-		ch.Close()
-		conn.Close()
-		return nil, fmt.Errorf("failed to declare queue: %w", err)
+		return fmt.Errorf("failed to declare delay queue: %w", err)
 	}
 
-	return &Service{
-		conn:    conn,
-		channel: ch,
-		queue:   queueName,
-	}, nil
+	if err := ch.QueueBind(delayName(queueName), queueName, retryName(queueName), false, nil); err != nil {
+		return fmt.Errorf("failed to bind delay queue: %w", err)
+	}
+
+	_, err = ch.QueueDeclare(
+		deadLetterName(queueName), // name
+		true,                      // durable
+		false,                     // delete when unused
+		false,                     // exclusive
+		false,                     // no-wait
+		nil,                       // arguments
+	)
+	if err != nil {
+		return fmt.Errorf("failed to declare dead-letter queue: %w", err)
+	}
+
+	return nil
 }
 
-// PublishTask publishes task to queue
-func (s *Service) PublishTask(ctx context.Context, task *TranslationTask) error {
+// migrateLegacyQueues moves tasks of queues declared by earlier versions to the task queue and deletes those
+// queues: "<queue>" task queue without priority and "<queue>.retry" queue with fixed retry delay. A legacy
+// queue still consumed by an earlier version is kept and migrated on a later connect.
+func migrateLegacyQueues(conn connection, queueName string) {
+	for _, legacy := range []string{retryName(queueName), queueName} {
+		moved, err := migrateLegacyQueue(conn, queueName, legacy)
+		if err != nil {
+			log.Printf("Failed to migrate legacy queue %s: %v", legacy, err)
+			continue
+		}
+		if moved >= 0 {
+			log.Printf("Migrated %d tasks of legacy queue %s to queue %s", moved, legacy, taskQueueName(queueName))
+		}
+	}
+}
+
+// migrateLegacyQueue moves tasks of legacy queue to the task queue and deletes it, returns number of moved
+// tasks or -1 when legacy queue doesn't exist
+func migrateLegacyQueue(conn connection, queueName, legacy string) (int, error) {
+	// Passive declaration of missing queue closes its channel, so every queue gets its own
+	ch, err := conn.Channel()
+	if err != nil {
+		return 0, fmt.Errorf("failed to open channel: %w", err)
+	}
+	defer ch.Close()
+
+	if _, err := inspectQueue(ch, legacy); err != nil {
+		return -1, nil
+	}
+
+	if err := ch.Confirm(false); err != nil {
+		return 0, fmt.Errorf("failed to enable publisher confirms: %w", err)
+	}
+
+	// Legacy retry queue shares its name with the retry exchange, which must no longer route to it
+	if legacy == retryName(queueName) {
+		if err := ch.QueueUnbind(legacy, queueName, retryName(queueName), nil); err != nil {
+			return 0, fmt.Errorf("failed to unbind queue: %w", err)
+		}
+	}
+
+	moved := 0
+	for {
+		msg, ok, err := ch.Get(legacy, false)
+		if err != nil {
+			return moved, fmt.Errorf("failed to get task: %w", err)
+		}
+		if !ok {
+			break
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+		err = publishConfirmed(ctx, ch, "", taskQueueName(queueName), amqp.Publishing{
+			ContentType:  msg.ContentType,
+			DeliveryMode: amqp.Persistent,
+			Priority:     msg.Priority,
+			Headers:      msg.Headers,
+			Body:         msg.Body,
+		})
+		cancel()
+		if err != nil {
+			msg.Nack(false, true)
+			return moved, err
+		}
+		msg.Ack(false)
+		moved++
+	}
+
+	if _, err := ch.QueueDelete(legacy, true, true, false); err != nil {
+		return moved, fmt.Errorf("failed to delete queue: %w", err)
+	}
+	return moved, nil
+}
+
+// PublishTask publishes task to queue, returning once RabbitMQ confirmed it
+func (s *Service) PublishTask(ctx context.Context, task *translation.TranslationTask) error {
 	data, err := json.Marshal(task)
 	if err != nil {
 		return fmt.Errorf("failed to marshal task: %w", err)
 	}
 
-	err = s.channel.Publish(
-		"",      // exchange
-		s.queue, // routing key
-		false,   // mandatory
-		false,   // immediate
-		amqp.Publishing{
-			ContentType: "application/json",
-			Body:        data,
-		},
-	)
-
+	err = s.publish(ctx, "", taskQueueName(s.queue), amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Priority:     task.Priority,
+		Body:         data,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to publish task: %w", err)
 	}
@@ -85,61 +257,231 @@ func (s *Service) PublishTask(ctx context.Context, task *TranslationTask) error
 	return nil
 }
 
-// ConsumeTasks starts consuming tasks from queue
-func (s *Service) ConsumeTasks(ctx context.Context, handler func(*TranslationTask) error) error {
-	msgs, err := s.channel.Consume(
-		s.queue, // queue
-		"",      // consumer
-		false,   // auto-ack
-		false,   // exclusive
-		false,   // no-local
-		false,   // no-wait
-		nil,     // args
+// ConsumeTasks consumes tasks from queue with given number of workers until ctx is done, then waits
+// for tasks in progress to finish. Failed tasks are retried after retry delay; once all attempts fail, the task is moved
+// to the dead-letter queue and deadLetter is called with the last error.
+// The consumer is registered again whenever its channel or connection is lost.
+func (s *Service) ConsumeTasks(ctx context.Context, workers int, handler func(*translation.TranslationTask) error, deadLetter func(*translation.TranslationTask, error)) error {
+	ch, msgs, err := s.consume(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		s.runWorkers(ctx, workers, msgs, handler, deadLetter)
+		// Unacknowledged tasks return to the queue once the channel is closed
+		ch.Close()
+
+		ch, msgs, err = s.resume(ctx)
+		if err != nil {
+			// Consumer stops when ctx is done or service is closed
+			return nil
+		}
+	}
+}
+
+// consume opens consumer channel on current connection and starts consuming from task queue
+func (s *Service) consume(ctx context.Context) (channel, <-chan amqp.Delivery, error) {
+	conn, _, err := s.current(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open consumer channel: %w", err)
+	}
+
+	// Limit unacknowledged deliveries, so tasks are spread between consumers instead of piling up in one
+	if err := ch.Qos(s.prefetch, 0, false); err != nil {
+		ch.Close()
+		return nil, nil, fmt.Errorf("failed to set QoS: %w", err)
+	}
+
+	msgs, err := ch.Consume(
+		taskQueueName(s.queue), // queue
+		"",                     // consumer
+		false,                  // auto-ack
+		false,                  // exclusive
+		false,                  // no-local
+		false,                  // no-wait
+		nil,                    // args
 	)
 	if err != nil {
-		return fmt.Errorf("failed to start consuming: %w", err)
-	}
-
-	go func() {
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case msg := <-msgs:
-				var task TranslationTask
-				if err := json.Unmarshal(msg.Body, &task); err != nil {
-					log.Printf("Failed to unmarshal task: %v", err)
-					msg.Ack(false)
-					continue
-				}
+		ch.Close()
+		return nil, nil, fmt.Errorf("failed to start consuming: %w", err)
+	}
 
-				log.Printf("Processing translation task for request ID: %s", task.RequestID)
+	return ch, msgs, nil
+}
+
+// resume registers consumer again with backoff until it succeeds, ctx is done or service is closed
+func (s *Service) resume(ctx context.Context) (channel, <-chan amqp.Delivery, error) {
+	delay := minReconnectDelay
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		ch, msgs, err := s.consume(ctx)
+		if err == nil {
+			log.Printf("Consumer of queue %s registered again", s.queue)
+			return ch, msgs, nil
+		}
+		if errors.Is(err, errServiceClosed) {
+			return nil, nil, err
+		}
+
+		log.Printf("Failed to register consumer, retrying in %s: %v", delay, err)
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-s.done:
+			return nil, nil, errServiceClosed
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
 
-				if err := handler(&task); err != nil {
-					log.Printf("Failed to process task: %v", err)
-					// Send message to error queue or log
-					msg.Nack(false, true) // requeue
-				} else {
-					msg.Ack(false)
-					log.Printf("Successfully processed translation task for request ID: %s", task.RequestID)
+// runWorkers processes deliveries with given number of workers until deliveries are closed or ctx is done,
+// waiting for tasks in progress to finish
+func (s *Service) runWorkers(ctx context.Context, workers int, msgs <-chan amqp.Delivery, handler func(*translation.TranslationTask) error, deadLetter func(*translation.TranslationTask, error)) {
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case msg, ok := <-msgs:
+					if !ok {
+						return
+					}
+					s.handleDelivery(msg, handler, deadLetter)
 				}
 			}
-		}
-	}()
+		}()
+	}
+	wg.Wait()
+}
 
-	return nil
+// handleDelivery processes one delivered task and schedules retry or dead-letters it on failure
+func (s *Service) handleDelivery(msg amqp.Delivery, handler func(*translation.TranslationTask) error, deadLetter func(*translation.TranslationTask, error)) {
+	var task translation.TranslationTask
+	if err := json.Unmarshal(msg.Body, &task); err != nil {
+		// Malformed tasks never succeed, keep them for inspection
+		log.Printf("Failed to unmarshal task: %v", err)
+		s.moveToDeadLetters(msg, retryCount(msg.Headers)+1, err)
+		return
+	}
+
+	log.Printf("Processing translation task for request ID: %s", task.RequestID)
+
+	err := handler(&task)
+	if err == nil {
+		msg.Ack(false)
+		log.Printf("Successfully processed translation task for request ID: %s", task.RequestID)
+		return
+	}
+
+	attempts := retryCount(msg.Headers) + 1
+	log.Printf("Failed to process task for request ID %s (attempt %d/%d): %v", task.RequestID, attempts, s.retry.MaxAttempts, err)
+
+	if attempts < s.retry.MaxAttempts {
+		s.scheduleRetry(msg, attempts, err)
+		return
+	}
+
+	if s.moveToDeadLetters(msg, attempts, err) {
+		deadLetter(&task, err)
+	}
 }
 
-// Close closes connection to RabbitMQ
+// scheduleRetry publishes task to retry exchange with incremented retry count and acknowledges the delivery
+// once the retry is confirmed. Task expires after retry delay. Expired tasks only leave the front of the delay
+// queue, which is fine as all tasks wait for the same delay.
+func (s *Service) scheduleRetry(msg amqp.Delivery, attempts int, cause error) {
+	err := s.publish(context.Background(), retryName(s.queue), s.queue, amqp.Publishing{
+		ContentType:  msg.ContentType,
+		DeliveryMode: amqp.Persistent,
+		Priority:     msg.Priority,
+		Expiration:   strconv.FormatInt(s.retry.Delay.Milliseconds(), 10),
+		Headers: amqp.Table{
+			headerRetryCount: int64(attempts),
+			headerLastError:  cause.Error(),
+		},
+		Body: msg.Body,
+	})
+	if err != nil {
+		log.Printf("Failed to schedule task retry: %v", err)
+		msg.Nack(false, true) // requeue
+		return
+	}
+
+	msg.Ack(false)
+}
+
+// moveToDeadLetters publishes task to dead-letter queue and acknowledges the delivery once
+// the dead letter is confirmed, reports success
+func (s *Service) moveToDeadLetters(msg amqp.Delivery, attempts int, cause error) bool {
+	err := s.publish(context.Background(), "", deadLetterName(s.queue), amqp.Publishing{
+		ContentType:  msg.ContentType,
+		DeliveryMode: amqp.Persistent,
+		Priority:     msg.Priority,
+		MessageId:    uuid.New().String(),
+		Timestamp:    time.Now(),
+		Headers: amqp.Table{
+			headerRetryCount:     int64(attempts),
+			headerLastError:      cause.Error(),
+			headerDeadLetteredAt: time.Now().UTC().Format(time.RFC3339Nano),
+		},
+		Body: msg.Body,
+	})
+	if err != nil {
+		log.Printf("Failed to dead-letter task: %v", err)
+		msg.Nack(false, true) // requeue
+		return false
+	}
+
+	msg.Ack(false)
+	log.Printf("Task moved to dead-letter queue after %d attempts: %v", attempts, cause)
+	return true
+}
+
+// retryCount reads retry count from message headers
+func retryCount(headers amqp.Table) int {
+	switch count := headers[headerRetryCount].(type) {
+	case int64:
+		return int(count)
+	case int32:
+		return int(count)
+	case int:
+		return count
+	}
+	return 0
+}
+
+// Close stops reconnecting and closes connection to RabbitMQ
 func (s *Service) Close() error {
-	if s.channel != nil {
-		if err := s.channel.Close(); err != nil {
+	s.closeOnce.Do(func() {
+		close(s.done)
+	})
+
+	s.mu.RLock()
+	conn, ch := s.conn, s.channel
+	s.mu.RUnlock()
+
+	if ch != nil && !ch.IsClosed() {
+		if err := ch.Close(); err != nil {
 			return fmt.Errorf("failed to close channel: %w", err)
 		}
 	}
 
-	if s.conn != nil {
-		if err := s.conn.Close(); err != nil {
+	if conn != nil && !conn.IsClosed() {
+		if err := conn.Close(); err != nil {
 			return fmt.Errorf("failed to close connection: %w", err)
 		}
 	}
@@ -149,10 +491,36 @@ func (s *Service) Close() error {
 
 // GetQueueInfo returns queue information
 func (s *Service) GetQueueInfo() (int, error) {
-	q, err := s.channel.QueueInspect(s.queue)
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+
+	_, ch, err := s.current(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	q, err := inspectQueue(ch, taskQueueName(s.queue))
 	if err != nil {
 		return 0, fmt.Errorf("failed to inspect queue: %w", err)
 	}
 
 	return q.Messages, nil
 }
+
+// HasRequestTask reports whether a task of request may still be queued. Queued tasks can't be looked up
+// without taking them, and RabbitMQ keeps published tasks until they are acknowledged, so it always does.
+func (s *Service) HasRequestTask(ctx context.Context, requestID uuid.UUID) (bool, error) {
+	return true, nil
+}
+
+// inspectQueue returns state of existing queue
+func inspectQueue(ch channel, name string) (amqp.Queue, error) {
+	return ch.QueueDeclarePassive(
+		name,  // name
+		true,  // durable
+		false, // delete when unused
+		false, // exclusive
+		false, // no-wait
+		nil,   // arguments
+	)
+}
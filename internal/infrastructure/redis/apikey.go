@@ -0,0 +1,98 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"translation/internal/domain/translation"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// apiKeyLastUsedKey is hash of API key IDs to the time they were last used in milliseconds, kept apart
+// from keys so recording use never overwrites changes to the key
+const apiKeyLastUsedKey = "api_key_last_used"
+
+// SaveAPIKey saves API key to Redis
+func (r *Repository) SaveAPIKey(ctx context.Context, key *translation.APIKey) error {
+	data, err := json.Marshal(key)
+	if err != nil {
+		return fmt.Errorf("failed to marshal api key: %w", err)
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Set(ctx, fmt.Sprintf("api_key:%s", key.ID), data, 0)
+	pipe.Set(ctx, fmt.Sprintf("api_key_hash:%s", key.Hash), key.ID, 0)
+	pipe.SAdd(ctx, "api_keys", key.ID)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// GetAPIKey gets API key by ID from Redis
+func (r *Repository) GetAPIKey(ctx context.Context, id string) (*translation.APIKey, error) {
+	data, err := r.client.Get(ctx, fmt.Sprintf("api_key:%s", id)).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, fmt.Errorf("api key not found")
+		}
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	var key translation.APIKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal api key: %w", err)
+	}
+
+	lastUsed, err := r.client.HGet(ctx, apiKeyLastUsedKey, id).Int64()
+	if err == nil {
+		at := time.UnixMilli(lastUsed)
+		key.LastUsedAt = &at
+	} else if err != redis.Nil {
+		return nil, fmt.Errorf("failed to get api key last use: %w", err)
+	}
+
+	return &key, nil
+}
+
+// GetAPIKeyByHash gets API key by hash of its secret from Redis
+func (r *Repository) GetAPIKeyByHash(ctx context.Context, hash string) (*translation.APIKey, error) {
+	id, err := r.client.Get(ctx, fmt.Sprintf("api_key_hash:%s", hash)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, fmt.Errorf("api key not found")
+		}
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	return r.GetAPIKey(ctx, id)
+}
+
+// GetAPIKeys gets all API keys from Redis
+func (r *Repository) GetAPIKeys(ctx context.Context) ([]*translation.APIKey, error) {
+	ids, err := r.client.SMembers(ctx, "api_keys").Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api key IDs: %w", err)
+	}
+
+	var keys []*translation.APIKey
+	for _, id := range ids {
+		key, err := r.GetAPIKey(ctx, id)
+		if err != nil {
+			continue // Skip problematic keys
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// TouchAPIKey records use of API key in Redis
+func (r *Repository) TouchAPIKey(ctx context.Context, id string, at time.Time) error {
+	if err := r.client.HSet(ctx, apiKeyLastUsedKey, id, strconv.FormatInt(at.UnixMilli(), 10)).Err(); err != nil {
+		return fmt.Errorf("failed to record api key use: %w", err)
+	}
+	return nil
+}
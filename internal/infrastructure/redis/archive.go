@@ -0,0 +1,157 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// requestIndexKey is sorted set of stored request IDs scored by creation time in milliseconds
+	requestIndexKey = "translation_requests"
+
+	// summaryIndexKey is sorted set of archived request IDs scored by creation time in milliseconds
+	summaryIndexKey = "translation_request_summaries"
+)
+
+// summaryKey returns key of summary of archived request
+func summaryKey(id string) string {
+	return fmt.Sprintf("translation_request_summary:%s", id)
+}
+
+// scoreRange returns sorted set range of creation times between from and to, zero times are open
+func scoreRange(from time.Time, to time.Time) *redis.ZRangeBy {
+	rangeBy := &redis.ZRangeBy{Min: "-inf", Max: "+inf"}
+	if !from.IsZero() {
+		rangeBy.Min = strconv.FormatInt(from.UnixMilli(), 10)
+	}
+	if !to.IsZero() {
+		rangeBy.Max = strconv.FormatInt(to.UnixMilli(), 10)
+	}
+	return rangeBy
+}
+
+// GetRequestIDs gets IDs of requests created between from and to from Redis index
+func (r *Repository) GetRequestIDs(ctx context.Context, from time.Time, to time.Time) ([]uuid.UUID, error) {
+	members, err := r.client.ZRangeByScore(ctx, requestIndexKey, scoreRange(from, to)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get request IDs: %w", err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		id, err := uuid.Parse(member)
+		if err != nil {
+			continue // Skip problematic members
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// DeleteRequest deletes request with its key outcomes and chunk plan from Redis
+func (r *Repository) DeleteRequest(ctx context.Context, id uuid.UUID) error {
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx,
+		fmt.Sprintf("translation_request:%s", id.String()),
+		fmt.Sprintf("translation_request_results:%s", id.String()),
+		fmt.Sprintf("translation_request_chunks:%s", id.String()),
+	)
+	pipe.ZRem(ctx, requestIndexKey, id.String())
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete request: %w", err)
+	}
+
+	return nil
+}
+
+// SaveRequestSummary saves summary of archived request to Redis
+func (r *Repository) SaveRequestSummary(ctx context.Context, summary *translation.RequestSummary) error {
+	data, err := json.Marshal(summary)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request summary: %w", err)
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Set(ctx, summaryKey(summary.ID.String()), data, 0)
+	pipe.ZAdd(ctx, summaryIndexKey, redis.Z{Score: float64(summary.CreatedAt.UnixMilli()), Member: summary.ID.String()})
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to save request summary: %w", err)
+	}
+
+	return nil
+}
+
+// GetRequestSummaries gets summaries of archived requests created between from and to from Redis
+func (r *Repository) GetRequestSummaries(ctx context.Context, from time.Time, to time.Time) ([]*translation.RequestSummary, error) {
+	members, err := r.client.ZRangeByScore(ctx, summaryIndexKey, scoreRange(from, to)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get request summary IDs: %w", err)
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(members))
+	for _, member := range members {
+		keys = append(keys, summaryKey(member))
+	}
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get request summaries: %w", err)
+	}
+
+	summaries := make([]*translation.RequestSummary, 0, len(values))
+	for _, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			continue // Summary was deleted meanwhile
+		}
+
+		var summary translation.RequestSummary
+		if err := json.Unmarshal([]byte(data), &summary); err != nil {
+			continue // Skip problematic summaries
+		}
+		summaries = append(summaries, &summary)
+	}
+
+	return summaries, nil
+}
+
+// DeleteRequestSummariesBefore deletes summaries of archived requests created before given time from Redis
+func (r *Repository) DeleteRequestSummariesBefore(ctx context.Context, before time.Time) (int, error) {
+	members, err := r.client.ZRangeByScore(ctx, summaryIndexKey, &redis.ZRangeBy{
+		Min: "-inf",
+		Max: "(" + strconv.FormatInt(before.UnixMilli(), 10),
+	}).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get request summary IDs: %w", err)
+	}
+	if len(members) == 0 {
+		return 0, nil
+	}
+
+	keys := make([]string, 0, len(members))
+	ids := make([]interface{}, 0, len(members))
+	for _, member := range members {
+		keys = append(keys, summaryKey(member))
+		ids = append(ids, member)
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, keys...)
+	pipe.ZRem(ctx, summaryIndexKey, ids...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to delete request summaries: %w", err)
+	}
+
+	return len(members), nil
+}
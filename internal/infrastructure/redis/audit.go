@@ -0,0 +1,68 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"translation/internal/domain/translation"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// auditLogKey is sorted set of audit entries in JSON scored by their time in milliseconds. Entries carry
+// unique IDs, so equal entries never collapse into one member.
+const auditLogKey = "audit_log"
+
+// AppendAuditEntry appends entry to audit log in Redis
+func (r *Repository) AppendAuditEntry(ctx context.Context, entry *translation.AuditEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit entry: %w", err)
+	}
+
+	if err := r.client.ZAdd(ctx, auditLogKey, redis.Z{Score: float64(entry.CreatedAt.UnixMilli()), Member: string(data)}).Err(); err != nil {
+		return fmt.Errorf("failed to append audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// CountAuditEntries counts audit entries recorded between from and to in Redis
+func (r *Repository) CountAuditEntries(ctx context.Context, from time.Time, to time.Time) (int, error) {
+	rangeBy := scoreRange(from, to)
+	count, err := r.client.ZCount(ctx, auditLogKey, rangeBy.Min, rangeBy.Max).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count audit entries: %w", err)
+	}
+	return int(count), nil
+}
+
+// GetAuditEntries gets page of audit entries recorded between from and to from Redis
+func (r *Repository) GetAuditEntries(ctx context.Context, from time.Time, to time.Time, reverse bool, offset int, limit int) ([]*translation.AuditEntry, error) {
+	rangeBy := scoreRange(from, to)
+	members, err := r.client.ZRangeArgs(ctx, redis.ZRangeArgs{
+		Key:     auditLogKey,
+		Start:   rangeBy.Min,
+		Stop:    rangeBy.Max,
+		ByScore: true,
+		Rev:     reverse,
+		Offset:  int64(offset),
+		Count:   int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit entries: %w", err)
+	}
+
+	entries := make([]*translation.AuditEntry, 0, len(members))
+	for _, member := range members {
+		var entry translation.AuditEntry
+		if err := json.Unmarshal([]byte(member), &entry); err != nil {
+			continue // Skip problematic entries
+		}
+		entries = append(entries, &entry)
+	}
+
+	return entries, nil
+}
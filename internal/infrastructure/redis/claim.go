@@ -0,0 +1,94 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// claimKey returns key of name claimed by request
+func claimKey(name string) string {
+	return fmt.Sprintf("request_claim:%s", name)
+}
+
+// idempotentResponseKey returns key of response saved for idempotency claim name
+func idempotentResponseKey(name string) string {
+	return fmt.Sprintf("idempotent_response:%s", name)
+}
+
+// claimRequestKeyScript sets claim key to request ID unless it exists, expiring it after ARGV[2] milliseconds.
+// Returns ID of request holding the claim.
+var claimRequestKeyScript = redis.NewScript(`
+local owner = redis.call("GET", KEYS[1])
+if owner then
+	return owner
+end
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+return ARGV[1]
+`)
+
+// ClaimRequestKey claims name for request in Redis unless another request holds it
+func (r *Repository) ClaimRequestKey(ctx context.Context, name string, requestID uuid.UUID, ttl time.Duration) (uuid.UUID, bool, error) {
+	owner, err := claimRequestKeyScript.Run(ctx, r.client, []string{claimKey(name)}, requestID.String(), ttl.Milliseconds()).Text()
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("failed to claim request key: %w", err)
+	}
+
+	ownerID, err := uuid.Parse(owner)
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("failed to parse request key owner: %w", err)
+	}
+	return ownerID, ownerID == requestID, nil
+}
+
+// releaseRequestKeyScript deletes claim key only while it holds ID of the request
+var releaseRequestKeyScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// ReleaseRequestKey releases name in Redis if it is still held by request
+func (r *Repository) ReleaseRequestKey(ctx context.Context, name string, requestID uuid.UUID) error {
+	if err := releaseRequestKeyScript.Run(ctx, r.client, []string{claimKey(name)}, requestID.String()).Err(); err != nil {
+		return fmt.Errorf("failed to release request key: %w", err)
+	}
+	return nil
+}
+
+// SaveIdempotentResponse saves response for idempotency claim name in Redis
+func (r *Repository) SaveIdempotentResponse(ctx context.Context, name string, response *translation.IdempotentResponse, ttl time.Duration) error {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("failed to marshal idempotent response: %w", err)
+	}
+
+	if err := r.client.Set(ctx, idempotentResponseKey(name), data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+	return nil
+}
+
+// GetIdempotentResponse gets response saved for idempotency claim name from Redis
+func (r *Repository) GetIdempotentResponse(ctx context.Context, name string) (*translation.IdempotentResponse, error) {
+	data, err := r.client.Get(ctx, idempotentResponseKey(name)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get idempotent response: %w", err)
+	}
+
+	var response translation.IdempotentResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal idempotent response: %w", err)
+	}
+	return &response, nil
+}
@@ -0,0 +1,154 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// EventBus publishes request events through Redis pub/sub, so API and worker processes can be separate
+type EventBus struct {
+	client *redis.Client
+}
+
+// NewEventBus creates a new Redis event bus instance
+func NewEventBus(client *redis.Client) *EventBus {
+	return &EventBus{
+		client: client,
+	}
+}
+
+// eventChannel returns pub/sub channel of request events
+func eventChannel(requestID uuid.UUID) string {
+	return fmt.Sprintf("translation_events:%s", requestID.String())
+}
+
+// PublishEvent publishes event to subscribers of its request
+func (b *EventBus) PublishEvent(ctx context.Context, event *translation.RequestEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	if err := b.client.Publish(ctx, eventChannel(event.RequestID), data).Err(); err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+
+	return nil
+}
+
+// SubscribeEvents subscribes to events of request until ctx is done or returned cancel is called
+func (b *EventBus) SubscribeEvents(ctx context.Context, requestID uuid.UUID) (<-chan *translation.RequestEvent, func(), error) {
+	pubsub := b.client.Subscribe(ctx, eventChannel(requestID))
+
+	// Wait for subscription confirmation, so no event published after return is missed
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, nil, fmt.Errorf("failed to subscribe to events: %w", err)
+	}
+
+	events := make(chan *translation.RequestEvent)
+	done := make(chan struct{})
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(done)
+			pubsub.Close()
+		})
+	}
+
+	go func() {
+		defer close(events)
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				cancel()
+				return
+			case <-done:
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				var event translation.RequestEvent
+				if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+					continue // Skip problematic messages
+				}
+
+				select {
+				case events <- &event:
+				case <-ctx.Done():
+					cancel()
+					return
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+
+	return events, cancel, nil
+}
+
+// cancellationChannel is pub/sub channel cancelled request IDs are broadcast on to all workers
+const cancellationChannel = "translation_cancellations"
+
+// PublishCancellation broadcasts cancellation of request to all workers
+func (b *EventBus) PublishCancellation(ctx context.Context, requestID uuid.UUID) error {
+	if err := b.client.Publish(ctx, cancellationChannel, requestID.String()).Err(); err != nil {
+		return fmt.Errorf("failed to publish cancellation: %w", err)
+	}
+
+	return nil
+}
+
+// SubscribeCancellations subscribes to cancelled request IDs until ctx is done
+func (b *EventBus) SubscribeCancellations(ctx context.Context) (<-chan uuid.UUID, error) {
+	pubsub := b.client.Subscribe(ctx, cancellationChannel)
+
+	// Wait for subscription confirmation, so no cancellation published after return is missed
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, fmt.Errorf("failed to subscribe to cancellations: %w", err)
+	}
+
+	cancellations := make(chan uuid.UUID)
+	go func() {
+		defer close(cancellations)
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				requestID, err := uuid.Parse(message.Payload)
+				if err != nil {
+					continue // Skip problematic messages
+				}
+
+				select {
+				case cancellations <- requestID:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return cancellations, nil
+}
@@ -0,0 +1,55 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Locker represents locks shared by service instances through Redis. Each locker has its own owner token,
+// so a lock is only released by the instance that holds it.
+type Locker struct {
+	client *redis.Client
+	owner  string
+}
+
+// NewLocker creates a new Redis locker instance
+func NewLocker(client *redis.Client) *Locker {
+	return &Locker{
+		client: client,
+		owner:  uuid.New().String(),
+	}
+}
+
+// lockKey returns key of named lock
+func lockKey(name string) string {
+	return fmt.Sprintf("lock:%s", name)
+}
+
+// AcquireLock sets lock key unless it exists, it expires after ttl
+func (l *Locker) AcquireLock(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	acquired, err := l.client.SetNX(ctx, lockKey(name), l.owner, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire lock: %w", err)
+	}
+	return acquired, nil
+}
+
+// releaseLockScript deletes lock key only while it holds owner token of the caller
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// ReleaseLock deletes lock key if this locker holds it
+func (l *Locker) ReleaseLock(ctx context.Context, name string) error {
+	if err := releaseLockScript.Run(ctx, l.client, []string{lockKey(name)}, l.owner).Err(); err != nil {
+		return fmt.Errorf("failed to release lock: %w", err)
+	}
+	return nil
+}
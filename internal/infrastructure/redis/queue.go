@@ -0,0 +1,462 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Consumer group shared by all workers and how often delayed retries and stuck tasks are checked
+const (
+	queueGroup        = "translation_workers"
+	queueReadBlock    = time.Second
+	queuePollInterval = time.Second
+	queueClaimBatch   = 10
+)
+
+// Queue represents task queue on Redis Streams with the same contract as RabbitMQ service.
+// Workers read tasks through a consumer group; tasks left unacknowledged for claimIdle, e.g. because
+// their worker died, are claimed by other workers with XAUTOCLAIM. Failed tasks wait for retry
+// in "<stream>:retry" sorted set and are moved to "<stream>:dead" stream after all attempts.
+// "<stream>:requests" hash counts tasks of each request until they succeed or are dead-lettered.
+type Queue struct {
+	client    *redis.Client
+	stream    string
+	consumer  string
+	retry     translation.RetryPolicy
+	claimIdle time.Duration
+}
+
+// retryEntry represents failed task waiting for its next attempt
+type retryEntry struct {
+	ID       string `json:"id"`
+	Task     string `json:"task"`
+	Attempts string `json:"attempts"`
+	Error    string `json:"error"`
+}
+
+// NewQueue creates a new Redis Streams task queue
+func NewQueue(client *redis.Client, stream string, retry translation.RetryPolicy, claimIdle time.Duration) *Queue {
+	hostname, _ := os.Hostname()
+
+	return &Queue{
+		client:    client,
+		stream:    stream,
+		consumer:  fmt.Sprintf("%s-%s", hostname, uuid.New().String()[:8]),
+		retry:     retry,
+		claimIdle: claimIdle,
+	}
+}
+
+// PublishTask adds task to stream
+func (q *Queue) PublishTask(ctx context.Context, task *translation.TranslationTask) error {
+	data, err := json.Marshal(task)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task: %w", err)
+	}
+
+	pipe := q.client.TxPipeline()
+	pipe.XAdd(ctx, &redis.XAddArgs{
+		Stream: q.stream,
+		Values: map[string]interface{}{"task": data, "attempts": 0},
+	})
+	pipe.HIncrBy(ctx, q.requestsKey(), task.RequestID.String(), 1)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to publish task: %w", err)
+	}
+
+	log.Printf("Published translation task for request ID: %s", task.RequestID)
+	return nil
+}
+
+// ConsumeTasks consumes tasks from stream with given number of workers until ctx is done, then waits
+// for tasks in progress to finish. Failed tasks are retried after retry delay; once all attempts fail,
+// the task is moved to the dead-letter stream and deadLetter is called with the last error.
+func (q *Queue) ConsumeTasks(ctx context.Context, workers int, handler func(*translation.TranslationTask) error, deadLetter func(*translation.TranslationTask, error)) error {
+	// Start from the beginning of the stream, so tasks published before the group existed are processed
+	err := q.client.XGroupCreateMkStream(ctx, q.stream, queueGroup, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("failed to create consumer group: %w", err)
+	}
+
+	// Claimed tasks are handed to workers that are idle
+	claimed := make(chan redis.XMessage)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				select {
+				case msg := <-claimed:
+					q.handle(ctx, msg, handler, deadLetter)
+					continue
+				default:
+				}
+
+				msg, ok := q.read(ctx)
+				if ok {
+					q.handle(ctx, msg, handler, deadLetter)
+				}
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(queuePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return nil
+		case <-ticker.C:
+			q.moveDueRetries(ctx)
+			q.claimStuck(ctx, claimed)
+		}
+	}
+}
+
+// read waits for the next new task of the consumer group
+func (q *Queue) read(ctx context.Context) (redis.XMessage, bool) {
+	streams, err := q.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    queueGroup,
+		Consumer: q.consumer,
+		Streams:  []string{q.stream, ">"},
+		Count:    1,
+		Block:    queueReadBlock,
+	}).Result()
+	if err != nil {
+		if err != redis.Nil && ctx.Err() == nil {
+			log.Printf("Failed to read tasks: %v", err)
+			time.Sleep(queueReadBlock)
+		}
+		return redis.XMessage{}, false
+	}
+
+	for _, stream := range streams {
+		for _, msg := range stream.Messages {
+			return msg, true
+		}
+	}
+	return redis.XMessage{}, false
+}
+
+// claimStuck claims tasks other workers left unacknowledged for too long and hands them to idle workers.
+// Tasks that stopped their workers too many times are dead-lettered instead.
+func (q *Queue) claimStuck(ctx context.Context, claimed chan<- redis.XMessage) {
+	msgs, _, err := q.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   q.stream,
+		Group:    queueGroup,
+		MinIdle:  q.claimIdle,
+		Start:    "0-0",
+		Count:    queueClaimBatch,
+		Consumer: q.consumer,
+	}).Result()
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("Failed to claim stuck tasks: %v", err)
+		}
+		return
+	}
+
+	for _, msg := range msgs {
+		deliveries := q.deliveryCount(ctx, msg.ID)
+		log.Printf("Claimed task %s left unacknowledged for %s (delivery %d)", msg.ID, q.claimIdle, deliveries)
+
+		if deliveries > int64(q.retry.MaxAttempts) {
+			q.moveToDeadLetters(ctx, msg, deliveries-1, fmt.Errorf("task was abandoned by its worker %d times", deliveries-1))
+			continue
+		}
+
+		select {
+		case claimed <- msg:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// deliveryCount returns how many times pending task was delivered to workers
+func (q *Queue) deliveryCount(ctx context.Context, id string) int64 {
+	pending, err := q.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: q.stream,
+		Group:  queueGroup,
+		Start:  id,
+		End:    id,
+		Count:  1,
+	}).Result()
+	if err != nil || len(pending) == 0 {
+		return 1
+	}
+	return pending[0].RetryCount
+}
+
+// handle processes one task and schedules retry or dead-letters it on failure
+func (q *Queue) handle(ctx context.Context, msg redis.XMessage, handler func(*translation.TranslationTask) error, deadLetter func(*translation.TranslationTask, error)) {
+	attempts, _ := strconv.Atoi(fmt.Sprint(msg.Values["attempts"]))
+
+	var task translation.TranslationTask
+	if err := json.Unmarshal([]byte(fmt.Sprint(msg.Values["task"])), &task); err != nil {
+		// Malformed tasks never succeed, keep them for inspection
+		log.Printf("Failed to unmarshal task: %v", err)
+		q.moveToDeadLetters(ctx, msg, int64(attempts+1), err)
+		return
+	}
+
+	log.Printf("Processing translation task for request ID: %s", task.RequestID)
+
+	err := handler(&task)
+
+	// Outcome of a task that was in progress at shutdown is still recorded
+	ctx = context.WithoutCancel(ctx)
+	if err == nil {
+		pipe := q.client.TxPipeline()
+		q.forget(ctx, pipe, task.RequestID.String())
+		q.remove(ctx, pipe, msg.ID)
+		log.Printf("Successfully processed translation task for request ID: %s", task.RequestID)
+		return
+	}
+
+	attempts++
+	log.Printf("Failed to process task for request ID %s (attempt %d/%d): %v", task.RequestID, attempts, q.retry.MaxAttempts, err)
+
+	if attempts < q.retry.MaxAttempts {
+		q.scheduleRetry(ctx, msg, attempts, err)
+		return
+	}
+
+	if q.moveToDeadLetters(ctx, msg, int64(attempts), err) {
+		deadLetter(&task, err)
+	}
+}
+
+// remove acknowledges task and deletes it from stream together with commands queued in pipe
+func (q *Queue) remove(ctx context.Context, pipe redis.Pipeliner, id string) error {
+	pipe.XAck(ctx, q.stream, queueGroup, id)
+	pipe.XDel(ctx, q.stream, id)
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("Failed to acknowledge task %s: %v", id, err)
+		return err
+	}
+	return nil
+}
+
+// forgetScript stops counting task of request, removing request once it has no tasks
+var forgetScript = redis.NewScript(`
+if redis.call("HINCRBY", KEYS[1], ARGV[1], -1) <= 0 then
+	redis.call("HDEL", KEYS[1], ARGV[1])
+end
+return 0
+`)
+
+// forget queues decrement of task count of request in pipe
+func (q *Queue) forget(ctx context.Context, pipe redis.Pipeliner, requestID string) {
+	forgetScript.Eval(ctx, pipe, []string{q.requestsKey()}, requestID)
+}
+
+// scheduleRetry moves failed task to retry set until retry delay passes
+func (q *Queue) scheduleRetry(ctx context.Context, msg redis.XMessage, attempts int, cause error) {
+	entry, err := json.Marshal(retryEntry{
+		ID:       msg.ID,
+		Task:     fmt.Sprint(msg.Values["task"]),
+		Attempts: strconv.Itoa(attempts),
+		Error:    cause.Error(),
+	})
+	if err != nil {
+		log.Printf("Failed to schedule task retry: %v", err)
+		return
+	}
+
+	pipe := q.client.TxPipeline()
+	pipe.ZAdd(ctx, q.retryKey(), redis.Z{
+		Score:  float64(time.Now().Add(q.retry.Delay).UnixMilli()),
+		Member: string(entry),
+	})
+	// Task stays pending and is claimed again when this fails
+	if err := q.remove(ctx, pipe, msg.ID); err != nil {
+		log.Printf("Failed to schedule task retry: %v", err)
+	}
+}
+
+// moveDueRetriesScript adds failed tasks whose retry delay passed back to stream
+var moveDueRetriesScript = redis.NewScript(`
+local due = redis.call("ZRANGEBYSCORE", KEYS[1], "-inf", ARGV[1], "LIMIT", 0, 100)
+for _, data in ipairs(due) do
+	local entry = cjson.decode(data)
+	redis.call("XADD", KEYS[2], "*", "task", entry.task, "attempts", entry.attempts, "error", entry.error)
+	redis.call("ZREM", KEYS[1], data)
+end
+return #due
+`)
+
+// moveDueRetries returns failed tasks whose retry delay passed to stream
+func (q *Queue) moveDueRetries(ctx context.Context) {
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	if err := moveDueRetriesScript.Run(ctx, q.client, []string{q.retryKey(), q.stream}, now).Err(); err != nil && ctx.Err() == nil {
+		log.Printf("Failed to move due task retries: %v", err)
+	}
+}
+
+// moveToDeadLetters adds task to dead-letter stream and removes it from task stream, reports success
+func (q *Queue) moveToDeadLetters(ctx context.Context, msg redis.XMessage, attempts int64, cause error) bool {
+	pipe := q.client.TxPipeline()
+	pipe.XAdd(ctx, &redis.XAddArgs{
+		Stream: q.deadKey(),
+		Values: map[string]interface{}{
+			"task":             fmt.Sprint(msg.Values["task"]),
+			"attempts":         attempts,
+			"error":            cause.Error(),
+			"dead_lettered_at": time.Now().UTC().Format(time.RFC3339Nano),
+		},
+	})
+	// Malformed tasks were never counted
+	var task translation.TranslationTask
+	if err := json.Unmarshal([]byte(fmt.Sprint(msg.Values["task"])), &task); err == nil {
+		q.forget(ctx, pipe, task.RequestID.String())
+	}
+	// Task stays pending and is claimed again when this fails
+	if err := q.remove(ctx, pipe, msg.ID); err != nil {
+		log.Printf("Failed to dead-letter task: %v", err)
+		return false
+	}
+
+	log.Printf("Task moved to dead-letter stream after %d attempts: %v", attempts, cause)
+	return true
+}
+
+// GetDeadLetters returns up to limit dead-lettered tasks without removing them
+func (q *Queue) GetDeadLetters(ctx context.Context, limit int) ([]*translation.DeadLetter, error) {
+	msgs, err := q.client.XRangeN(ctx, q.deadKey(), "-", "+", int64(limit)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dead-lettered tasks: %w", err)
+	}
+
+	deadLetters := make([]*translation.DeadLetter, 0, len(msgs))
+	for _, msg := range msgs {
+		deadLetters = append(deadLetters, toDeadLetter(msg))
+	}
+
+	return deadLetters, nil
+}
+
+// ProcessDeadLetters calls handler for dead-lettered tasks with given IDs, all of them when ids is empty.
+// Tasks are removed once handler succeeds. Returns number of removed tasks.
+func (q *Queue) ProcessDeadLetters(ctx context.Context, ids []string, handler func(*translation.DeadLetter) error) (int, error) {
+	var msgs []redis.XMessage
+	if len(ids) == 0 {
+		all, err := q.client.XRange(ctx, q.deadKey(), "-", "+").Result()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get dead-lettered tasks: %w", err)
+		}
+		msgs = all
+	} else {
+		for _, id := range ids {
+			found, err := q.client.XRange(ctx, q.deadKey(), id, id).Result()
+			if err != nil {
+				// Malformed IDs match nothing
+				continue
+			}
+			msgs = append(msgs, found...)
+		}
+	}
+
+	processed := 0
+	for _, msg := range msgs {
+		if err := handler(toDeadLetter(msg)); err != nil {
+			return processed, err
+		}
+
+		if err := q.client.XDel(ctx, q.deadKey(), msg.ID).Err(); err != nil {
+			return processed, fmt.Errorf("failed to remove dead-lettered task: %w", err)
+		}
+		processed++
+	}
+
+	return processed, nil
+}
+
+// PurgeDeadLetters removes all dead-lettered tasks and returns their number
+func (q *Queue) PurgeDeadLetters(ctx context.Context) (int, error) {
+	pipe := q.client.TxPipeline()
+	count := pipe.XLen(ctx, q.deadKey())
+	pipe.Del(ctx, q.deadKey())
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to purge dead-lettered tasks: %w", err)
+	}
+
+	return int(count.Val()), nil
+}
+
+// GetQueueInfo returns number of tasks in stream that are waiting or being processed
+func (q *Queue) GetQueueInfo() (int, error) {
+	count, err := q.client.XLen(context.Background(), q.stream).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get stream length: %w", err)
+	}
+
+	return int(count), nil
+}
+
+// HasRequestTask reports whether a task of request is in stream or waiting for retry
+func (q *Queue) HasRequestTask(ctx context.Context, requestID uuid.UUID) (bool, error) {
+	count, err := q.client.HGet(ctx, q.requestsKey(), requestID.String()).Int()
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get tasks of request: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// Close releases queue resources, the Redis client is owned by the caller
+func (q *Queue) Close() error {
+	return nil
+}
+
+// retryKey returns key of sorted set with tasks waiting for retry
+func (q *Queue) retryKey() string {
+	return q.stream + ":retry"
+}
+
+// requestsKey returns key of hash with number of tasks of each request
+func (q *Queue) requestsKey() string {
+	return q.stream + ":requests"
+}
+
+// deadKey returns key of dead-letter stream
+func (q *Queue) deadKey() string {
+	return q.stream + ":dead"
+}
+
+// toDeadLetter converts dead-letter stream entry to dead letter, malformed tasks have no task
+func toDeadLetter(msg redis.XMessage) *translation.DeadLetter {
+	deadLetter := &translation.DeadLetter{
+		ID:    msg.ID,
+		Error: fmt.Sprint(msg.Values["error"]),
+	}
+
+	deadLetter.Attempts, _ = strconv.Atoi(fmt.Sprint(msg.Values["attempts"]))
+	deadLetter.DeadLetteredAt, _ = time.Parse(time.RFC3339Nano, fmt.Sprint(msg.Values["dead_lettered_at"]))
+
+	var task translation.TranslationTask
+	if err := json.Unmarshal([]byte(fmt.Sprint(msg.Values["task"])), &task); err == nil {
+		deadLetter.Task = &task
+	}
+
+	return deadLetter
+}
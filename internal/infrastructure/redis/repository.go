@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"translation/internal/domain/translation"
@@ -31,8 +33,16 @@ func (r *Repository) SaveRequest(ctx context.Context, request *translation.Trans
 		return fmt.Errorf("failed to marshal request: %w", err)
 	}
 
+	// Requests are kept until they are archived, the index lists them by creation time
 	key := fmt.Sprintf("translation_request:%s", request.ID.String())
-	return r.client.Set(ctx, key, data, 24*time.Hour).Err()
+	pipe := r.client.TxPipeline()
+	pipe.Set(ctx, key, data, 0)
+	pipe.ZAdd(ctx, requestIndexKey, redis.Z{Score: float64(request.CreatedAt.UnixMilli()), Member: request.ID.String()})
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	return nil
 }
 
 // GetRequestByID gets request by ID from Redis
@@ -67,6 +77,62 @@ func (r *Repository) UpdateRequestStatus(ctx context.Context, id uuid.UUID, stat
 	return r.SaveRequest(ctx, request)
 }
 
+// finishRequestAttempts is how many times finishing request is tried while it is changed concurrently
+const finishRequestAttempts = 10
+
+// FinishRequest applies finish to request unless it already finished, reporting whether it was applied.
+// The request is watched, so finishing is tried again when it changes before it is saved.
+func (r *Repository) FinishRequest(ctx context.Context, id uuid.UUID, finish func(*translation.TranslationRequest)) (bool, error) {
+	key := fmt.Sprintf("translation_request:%s", id.String())
+
+	var finished bool
+	update := func(tx *redis.Tx) error {
+		finished = false
+
+		data, err := tx.Get(ctx, key).Bytes()
+		if err != nil {
+			if err == redis.Nil {
+				return fmt.Errorf("request not found")
+			}
+			return fmt.Errorf("failed to get request: %w", err)
+		}
+
+		var request translation.TranslationRequest
+		if err := json.Unmarshal(data, &request); err != nil {
+			return fmt.Errorf("failed to unmarshal request: %w", err)
+		}
+		if request.Status.IsFinal() {
+			return nil
+		}
+
+		finish(&request)
+		data, err = json.Marshal(&request)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, data, 0)
+			return nil
+		})
+		finished = err == nil
+		return err
+	}
+
+	for i := 0; i < finishRequestAttempts; i++ {
+		err := r.client.Watch(ctx, update, key)
+		if err == redis.TxFailedErr {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		return finished, nil
+	}
+
+	return false, fmt.Errorf("failed to finish request: it kept changing")
+}
+
 // SaveTranslationKey saves translation key to Redis
 func (r *Repository) SaveTranslationKey(ctx context.Context, key *translation.TranslationKey) error {
 	data, err := json.Marshal(key)
@@ -97,6 +163,69 @@ func (r *Repository) GetTranslationKey(ctx context.Context, key string) (*transl
 	return &translationKey, nil
 }
 
+// setKeyTranslationScript updates one translation of a stored key in place, so concurrent updates
+// of different languages of the same key never overwrite each other.
+// Returns nil when key doesn't exist, otherwise {existed, previous}.
+var setKeyTranslationScript = redis.NewScript(`
+local data = redis.call("GET", KEYS[1])
+if not data then
+	return false
+end
+local key = cjson.decode(data)
+if type(key.translations) ~= "table" then
+	key.translations = {}
+end
+local previous = key.translations[ARGV[1]]
+key.translations[ARGV[1]] = ARGV[2]
+redis.call("SET", KEYS[1], cjson.encode(key))
+if previous then
+	return {1, previous}
+end
+return {0, ""}
+`)
+
+// SetKeyTranslation sets translation of key to language in Redis
+func (r *Repository) SetKeyTranslation(ctx context.Context, key string, language string, value string) (string, bool, error) {
+	redisKey := fmt.Sprintf("translation_key:%s", key)
+	result, err := setKeyTranslationScript.Run(ctx, r.client, []string{redisKey}, language, value).Slice()
+	if err != nil {
+		if err == redis.Nil {
+			return "", false, fmt.Errorf("translation key not found")
+		}
+		return "", false, fmt.Errorf("failed to set translation: %w", err)
+	}
+
+	existed, _ := result[0].(int64)
+	previous, _ := result[1].(string)
+	return previous, existed == 1, nil
+}
+
+// removeKeyTranslationScript removes one translation of a stored key in place if it still has the given value.
+// Returns 1 when it was removed, otherwise 0.
+var removeKeyTranslationScript = redis.NewScript(`
+local data = redis.call("GET", KEYS[1])
+if not data then
+	return 0
+end
+local key = cjson.decode(data)
+if type(key.translations) ~= "table" or key.translations[ARGV[1]] ~= ARGV[2] then
+	return 0
+end
+key.translations[ARGV[1]] = nil
+redis.call("SET", KEYS[1], cjson.encode(key))
+return 1
+`)
+
+// RemoveKeyTranslation removes translation of key to language in Redis if it still has given value
+func (r *Repository) RemoveKeyTranslation(ctx context.Context, key string, language string, value string) (bool, error) {
+	redisKey := fmt.Sprintf("translation_key:%s", key)
+	removed, err := removeKeyTranslationScript.Run(ctx, r.client, []string{redisKey}, language, value).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to remove translation: %w", err)
+	}
+	return removed == 1, nil
+}
+
 // GetAllTranslationKeys gets all translation keys from Redis
 func (r *Repository) GetAllTranslationKeys(ctx context.Context) ([]*translation.TranslationKey, error) {
 	pattern := "translation_key:*"
@@ -168,7 +297,7 @@ func (r *Repository) DeleteTranslationKey(ctx context.Context, key string) error
 	return r.client.Del(ctx, redisKey).Err()
 }
 
-// GetIncompleteRequests gets all requests that are not completed, failed, or cancelled
+// GetIncompleteRequests gets all requests that are not in a final status
 func (r *Repository) GetIncompleteRequests(ctx context.Context) ([]*translation.TranslationRequest, error) {
 	pattern := "translation_request:*"
 	keys, err := r.client.Keys(ctx, pattern).Result()
@@ -188,13 +317,359 @@ func (r *Repository) GetIncompleteRequests(ctx context.Context) ([]*translation.
 			continue // Skip problematic keys
 		}
 
-		// Only include requests that are not completed, failed, or cancelled
-		if request.Status != translation.StatusCompleted &&
-			request.Status != translation.StatusFailed &&
-			request.Status != translation.StatusCancelled {
+		// Only include requests that are still pending or processing
+		if !request.Status.IsFinal() {
 			incompleteRequests = append(incompleteRequests, &request)
 		}
 	}
 
 	return incompleteRequests, nil
 }
+
+// SaveKeyResults saves outcomes of request keys to Redis hash, one field per key language
+func (r *Repository) SaveKeyResults(ctx context.Context, requestID uuid.UUID, results []*translation.KeyResult) error {
+	fields := make([]interface{}, 0, len(results)*2)
+	for _, result := range results {
+		data, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to marshal key result: %w", err)
+		}
+		fields = append(fields, result.Language+":"+result.Key, data)
+	}
+
+	// Results are deleted together with the request
+	key := fmt.Sprintf("translation_request_results:%s", requestID.String())
+	if err := r.client.HSet(ctx, key, fields...).Err(); err != nil {
+		return fmt.Errorf("failed to save key results: %w", err)
+	}
+
+	return nil
+}
+
+// GetKeyResults gets outcomes of request keys from Redis
+func (r *Repository) GetKeyResults(ctx context.Context, requestID uuid.UUID) ([]*translation.KeyResult, error) {
+	key := fmt.Sprintf("translation_request_results:%s", requestID.String())
+	values, err := r.client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key results: %w", err)
+	}
+
+	var results []*translation.KeyResult
+	for _, value := range values {
+		var result translation.KeyResult
+		if err := json.Unmarshal([]byte(value), &result); err != nil {
+			continue // Skip problematic results
+		}
+		results = append(results, &result)
+	}
+
+	return results, nil
+}
+
+// DeleteKeyResults deletes outcomes of request keys from Redis hash
+func (r *Repository) DeleteKeyResults(ctx context.Context, requestID uuid.UUID, results []*translation.KeyResult) error {
+	if len(results) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(results))
+	for _, result := range results {
+		fields = append(fields, result.Language+":"+result.Key)
+	}
+
+	key := fmt.Sprintf("translation_request_results:%s", requestID.String())
+	if err := r.client.HDel(ctx, key, fields...).Err(); err != nil {
+		return fmt.Errorf("failed to delete key results: %w", err)
+	}
+
+	return nil
+}
+
+// SaveChunkPlan replaces chunk plan of request in Redis
+func (r *Repository) SaveChunkPlan(ctx context.Context, requestID uuid.UUID, planID uuid.UUID, count int) error {
+	// Plan is only needed while request is processed, so it still expires
+	key := fmt.Sprintf("translation_request_chunks:%s", requestID.String())
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, key, "plan", planID.String(), "total", count)
+	pipe.Expire(ctx, key, 24*time.Hour)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to save chunk plan: %w", err)
+	}
+
+	return nil
+}
+
+// GetChunkPlan gets ID of current chunk plan of request from Redis
+func (r *Repository) GetChunkPlan(ctx context.Context, requestID uuid.UUID) (*uuid.UUID, error) {
+	value, err := r.client.HGet(ctx, fmt.Sprintf("translation_request_chunks:%s", requestID.String()), "plan").Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chunk plan: %w", err)
+	}
+
+	planID, err := uuid.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse chunk plan: %w", err)
+	}
+	return &planID, nil
+}
+
+// completeChunkScript marks chunk as done if it belongs to the current plan and wasn't done before,
+// returning 1 only when it was the last outstanding chunk
+var completeChunkScript = redis.NewScript(`
+if redis.call("HGET", KEYS[1], "plan") ~= ARGV[1] then
+	return 0
+end
+if redis.call("SADD", KEYS[2], ARGV[2]) == 0 then
+	return 0
+end
+redis.call("EXPIRE", KEYS[2], ARGV[3])
+if redis.call("SCARD", KEYS[2]) == tonumber(redis.call("HGET", KEYS[1], "total")) then
+	return 1
+end
+return 0
+`)
+
+// CompleteChunk marks chunk of plan as done in Redis
+func (r *Repository) CompleteChunk(ctx context.Context, requestID uuid.UUID, planID uuid.UUID, chunk int) (bool, error) {
+	keys := []string{
+		fmt.Sprintf("translation_request_chunks:%s", requestID.String()),
+		fmt.Sprintf("translation_request_chunks_done:%s:%s", requestID.String(), planID.String()),
+	}
+	last, err := completeChunkScript.Run(ctx, r.client, keys, planID.String(), chunk, int((24 * time.Hour).Seconds())).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to complete chunk: %w", err)
+	}
+
+	return last == 1, nil
+}
+
+// appendChangeScript allocates the next change sequence and indexes the change in one atomic step,
+// so readers never observe a higher sequence before a lower one.
+// Change log members are "<version>:<key>:<sequence>" and reference history entries.
+var appendChangeScript = redis.NewScript(`
+local sequence = redis.call("INCR", KEYS[1])
+redis.call("ZADD", KEYS[2], sequence, ARGV[1] .. ":" .. sequence)
+redis.call("ZADD", KEYS[3], ARGV[2], sequence)
+return sequence
+`)
+
+// AppendHistory appends entries to translation key history in Redis
+func (r *Repository) AppendHistory(ctx context.Context, entries []*translation.HistoryEntry) error {
+	for _, entry := range entries {
+		version, err := r.client.Incr(ctx, fmt.Sprintf("translation_history_version:%s", entry.Key)).Result()
+		if err != nil {
+			return fmt.Errorf("failed to allocate history version: %w", err)
+		}
+		entry.Version = version
+
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("failed to marshal history entry: %w", err)
+		}
+
+		historyKey := fmt.Sprintf("translation_history:%s", entry.Key)
+		if err := r.client.RPush(ctx, historyKey, data).Err(); err != nil {
+			return fmt.Errorf("failed to append history entry: %w", err)
+		}
+
+		if err := r.client.SAdd(ctx, "translation_history_keys", entry.Key).Err(); err != nil {
+			return fmt.Errorf("failed to index history key: %w", err)
+		}
+
+		// Change is published to the change log only after its history entry is readable
+		sequence, err := appendChangeScript.Run(ctx, r.client,
+			[]string{"translation_change_sequence", "translation_changes", "translation_change_times"},
+			fmt.Sprintf("%d:%s", entry.Version, entry.Key), entry.CreatedAt.UnixMicro(),
+		).Int64()
+		if err != nil {
+			return fmt.Errorf("failed to allocate change sequence: %w", err)
+		}
+		entry.Sequence = sequence
+	}
+
+	return nil
+}
+
+// GetKeyHistory gets history of translation key from Redis
+func (r *Repository) GetKeyHistory(ctx context.Context, key string) ([]*translation.HistoryEntry, error) {
+	historyKey := fmt.Sprintf("translation_history:%s", key)
+	items, err := r.client.LRange(ctx, historyKey, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history: %w", err)
+	}
+
+	var history []*translation.HistoryEntry
+	for _, item := range items {
+		var entry translation.HistoryEntry
+		if err := json.Unmarshal([]byte(item), &entry); err != nil {
+			continue // Skip problematic entries
+		}
+
+		history = append(history, &entry)
+	}
+
+	return history, nil
+}
+
+// GetHistoryKeys gets all keys that have history from Redis
+func (r *Repository) GetHistoryKeys(ctx context.Context) ([]string, error) {
+	keys, err := r.client.SMembers(ctx, "translation_history_keys").Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// SaveRelease saves release to Redis, failing if it already exists
+func (r *Repository) SaveRelease(ctx context.Context, release *translation.Release) error {
+	data, err := json.Marshal(release)
+	if err != nil {
+		return fmt.Errorf("failed to marshal release: %w", err)
+	}
+
+	// Releases are immutable, so never overwrite an existing one
+	key := fmt.Sprintf("translation_release:%s", release.Name)
+	created, err := r.client.SetNX(ctx, key, data, 0).Result()
+	if err != nil {
+		return fmt.Errorf("failed to save release: %w", err)
+	}
+	if !created {
+		return fmt.Errorf("release already exists")
+	}
+
+	if err := r.client.SAdd(ctx, "translation_releases", release.Name).Err(); err != nil {
+		return fmt.Errorf("failed to index release: %w", err)
+	}
+
+	return nil
+}
+
+// GetRelease gets release by name from Redis
+func (r *Repository) GetRelease(ctx context.Context, name string) (*translation.Release, error) {
+	key := fmt.Sprintf("translation_release:%s", name)
+	data, err := r.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, fmt.Errorf("release not found")
+		}
+		return nil, fmt.Errorf("failed to get release: %w", err)
+	}
+
+	var release translation.Release
+	if err := json.Unmarshal(data, &release); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal release: %w", err)
+	}
+
+	return &release, nil
+}
+
+// GetAllReleases gets all releases from Redis
+func (r *Repository) GetAllReleases(ctx context.Context) ([]*translation.Release, error) {
+	names, err := r.client.SMembers(ctx, "translation_releases").Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get release names: %w", err)
+	}
+
+	var releases []*translation.Release
+	for _, name := range names {
+		release, err := r.GetRelease(ctx, name)
+		if err != nil {
+			continue // Skip problematic releases
+		}
+
+		releases = append(releases, release)
+	}
+
+	return releases, nil
+}
+
+// PublishRelease marks release as published in Redis
+func (r *Repository) PublishRelease(ctx context.Context, name string, publishedAt time.Time) error {
+	release, err := r.GetRelease(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	release.PublishedAt = &publishedAt
+
+	data, err := json.Marshal(release)
+	if err != nil {
+		return fmt.Errorf("failed to marshal release: %w", err)
+	}
+
+	// Only publication metadata changes, the release itself must already exist
+	key := fmt.Sprintf("translation_release:%s", name)
+	return r.client.SetXX(ctx, key, data, 0).Err()
+}
+
+// GetChangesSince gets changes recorded after sequence from Redis
+func (r *Repository) GetChangesSince(ctx context.Context, sequence int64) ([]*translation.HistoryEntry, error) {
+	members, err := r.client.ZRangeByScoreWithScores(ctx, "translation_changes", &redis.ZRangeBy{
+		Min: fmt.Sprintf("(%d", sequence),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get changes: %w", err)
+	}
+
+	// Load history of every changed key once
+	histories := make(map[string]map[int64]*translation.HistoryEntry)
+	var changes []*translation.HistoryEntry
+	for _, z := range members {
+		member := z.Member
+
+		// Member format is "<version>:<key>:<sequence>", key itself may contain colons
+		first := strings.Index(member, ":")
+		last := strings.LastIndex(member, ":")
+		if first < 0 || last <= first {
+			continue // Skip problematic members
+		}
+
+		version, err := strconv.ParseInt(member[:first], 10, 64)
+		if err != nil {
+			continue // Skip problematic members
+		}
+		key := member[first+1 : last]
+
+		if histories[key] == nil {
+			history, err := r.GetKeyHistory(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+			histories[key] = make(map[int64]*translation.HistoryEntry, len(history))
+			for _, entry := range history {
+				histories[key][entry.Version] = entry
+			}
+		}
+
+		if entry, exists := histories[key][version]; exists {
+			change := *entry
+			change.Sequence = int64(z.Score)
+			changes = append(changes, &change)
+		}
+	}
+
+	return changes, nil
+}
+
+// GetSequenceAt gets sequence of the last change recorded at or before given time from Redis
+func (r *Repository) GetSequenceAt(ctx context.Context, at time.Time) (int64, error) {
+	sequences, err := r.client.ZRevRangeByScore(ctx, "translation_change_times", &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(at.UnixMicro(), 10),
+		Count: 1,
+	}).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get change sequence: %w", err)
+	}
+
+	if len(sequences) == 0 {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(sequences[0], 10, 64)
+}
@@ -0,0 +1,187 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Backlog scores order chunks by priority first and by the time they were added second
+const backlogPriorityWeight = 1e12
+
+// ScheduleChunks adds chunks to project backlog sorted set in Redis
+func (r *Repository) ScheduleChunks(ctx context.Context, project string, chunks []*translation.ScheduledChunk) error {
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	// Reserve a block of sequence numbers, so chunks of one call keep their order
+	last, err := r.client.IncrBy(ctx, "chunk_backlog_sequence", int64(len(chunks))).Result()
+	if err != nil {
+		return fmt.Errorf("failed to allocate backlog sequence: %w", err)
+	}
+	first := last - int64(len(chunks)) + 1
+
+	members := make([]redis.Z, 0, len(chunks))
+	for i, chunk := range chunks {
+		data, err := json.Marshal(chunk)
+		if err != nil {
+			return fmt.Errorf("failed to marshal chunk: %w", err)
+		}
+
+		score := float64(translation.MaxPriority-chunk.Priority)*backlogPriorityWeight + float64(first+int64(i))
+		members = append(members, redis.Z{Score: score, Member: string(data)})
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.ZAdd(ctx, backlogKey(project), members...)
+	pipe.SAdd(ctx, "chunk_backlog_projects", project)
+	for _, chunk := range chunks {
+		pipe.HIncrBy(ctx, "chunk_backlog_requests", chunk.RequestID.String(), 1)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to schedule chunks: %w", err)
+	}
+
+	return nil
+}
+
+// releaseChunksScript drops expired leases, then moves chunks from backlog to in flight until limit chunks
+// are in flight and returns them. Project is removed from scheduled projects once its backlog is empty.
+// Backlog chunk counts of requests are decremented for released chunks.
+var releaseChunksScript = redis.NewScript(`
+redis.call("ZREMRANGEBYSCORE", KEYS[2], "-inf", ARGV[1])
+local free = tonumber(ARGV[3]) - redis.call("ZCARD", KEYS[2])
+local released = {}
+if free > 0 then
+	released = redis.call("ZRANGE", KEYS[1], 0, free - 1)
+	for _, data in ipairs(released) do
+		local chunk = cjson.decode(data)
+		redis.call("ZREM", KEYS[1], data)
+		redis.call("ZADD", KEYS[2], ARGV[2], chunk.request_id .. ":" .. chunk.plan_id .. ":" .. string.format("%d", chunk.index))
+		if redis.call("HINCRBY", KEYS[4], chunk.request_id, -1) <= 0 then
+			redis.call("HDEL", KEYS[4], chunk.request_id)
+		end
+	end
+end
+if redis.call("ZCARD", KEYS[1]) == 0 then
+	redis.call("SREM", KEYS[3], ARGV[4])
+end
+return released
+`)
+
+// ReleaseChunks moves chunks from project backlog to in flight sorted set scored by lease expiry in Redis
+func (r *Repository) ReleaseChunks(ctx context.Context, project string, limit int, leaseUntil time.Time) ([]*translation.ScheduledChunk, error) {
+	keys := []string{backlogKey(project), inFlightKey(project), "chunk_backlog_projects", "chunk_backlog_requests"}
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	lease := strconv.FormatInt(leaseUntil.UnixMilli(), 10)
+
+	values, err := releaseChunksScript.Run(ctx, r.client, keys, now, lease, limit, project).StringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to release chunks: %w", err)
+	}
+
+	chunks := make([]*translation.ScheduledChunk, 0, len(values))
+	for _, value := range values {
+		var chunk translation.ScheduledChunk
+		if err := json.Unmarshal([]byte(value), &chunk); err != nil {
+			continue // Skip problematic chunks
+		}
+		chunks = append(chunks, &chunk)
+	}
+
+	return chunks, nil
+}
+
+// FinishChunk removes chunk from project chunks in flight in Redis
+func (r *Repository) FinishChunk(ctx context.Context, project string, chunkID string) error {
+	return r.client.ZRem(ctx, inFlightKey(project), chunkID).Err()
+}
+
+// GetScheduledProjects gets projects with chunks in backlog from Redis
+func (r *Repository) GetScheduledProjects(ctx context.Context) ([]string, error) {
+	projects, err := r.client.SMembers(ctx, "chunk_backlog_projects").Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled projects: %w", err)
+	}
+	return projects, nil
+}
+
+// CountScheduledChunks gets number of chunks of request in project backlogs from Redis
+func (r *Repository) CountScheduledChunks(ctx context.Context, requestID uuid.UUID) (int, error) {
+	count, err := r.client.HGet(ctx, "chunk_backlog_requests", requestID.String()).Int()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to count scheduled chunks: %w", err)
+	}
+	return count, nil
+}
+
+// ExtendRequestLease extends lease of request in request leases sorted set scored by expiry in Redis
+func (r *Repository) ExtendRequestLease(ctx context.Context, requestID uuid.UUID, until time.Time) error {
+	// GT only ever moves expiry forward, new members are added as usual
+	return r.client.ZAddArgs(ctx, "request_leases", redis.ZAddArgs{
+		GT:      true,
+		Members: []redis.Z{{Score: float64(until.UnixMilli()), Member: requestID.String()}},
+	}).Err()
+}
+
+// GetRequestLease gets lease expiry of request from Redis
+func (r *Repository) GetRequestLease(ctx context.Context, requestID uuid.UUID) (*time.Time, error) {
+	score, err := r.client.ZScore(ctx, "request_leases", requestID.String()).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get request lease: %w", err)
+	}
+
+	until := time.UnixMilli(int64(score))
+	return &until, nil
+}
+
+// GetExpiredRequestLeases gets requests with expired leases from Redis
+func (r *Repository) GetExpiredRequestLeases(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	members, err := r.client.ZRangeByScore(ctx, "request_leases", &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(now.UnixMilli(), 10),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired request leases: %w", err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		id, err := uuid.Parse(member)
+		if err != nil {
+			continue // Skip problematic leases
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// RemoveRequestLease removes lease of request from Redis
+func (r *Repository) RemoveRequestLease(ctx context.Context, requestID uuid.UUID) error {
+	return r.client.ZRem(ctx, "request_leases", requestID.String()).Err()
+}
+
+// backlogKey returns key of project backlog sorted set
+func backlogKey(project string) string {
+	return fmt.Sprintf("chunk_backlog:%s", project)
+}
+
+// inFlightKey returns key of project chunks in flight sorted set
+func inFlightKey(project string) string {
+	return fmt.Sprintf("chunk_in_flight:%s", project)
+}
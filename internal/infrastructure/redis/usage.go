@@ -0,0 +1,82 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"translation/internal/domain/translation"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// UsageCounter represents usage counters shared by service instances through Redis
+type UsageCounter struct {
+	client *redis.Client
+}
+
+// NewUsageCounter creates a new Redis usage counter instance
+func NewUsageCounter(client *redis.Client) *UsageCounter {
+	return &UsageCounter{client: client}
+}
+
+// addUsageScript adds increments to counters given in KEYS unless one would exceed its limit. ARGV holds
+// amount, limit (0 means unlimited) and expiry time in milliseconds of every counter. Returns counter
+// values followed by 1 when increments were added, 0 otherwise.
+var addUsageScript = redis.NewScript(`
+local values = {}
+local allowed = 1
+for i, key in ipairs(KEYS) do
+	values[i] = tonumber(redis.call("GET", key) or "0")
+	local limit = tonumber(ARGV[i * 3 - 1])
+	if limit > 0 and values[i] + tonumber(ARGV[i * 3 - 2]) > limit then
+		allowed = 0
+	end
+end
+if allowed == 1 then
+	for i, key in ipairs(KEYS) do
+		values[i] = redis.call("INCRBY", key, ARGV[i * 3 - 2])
+		redis.call("PEXPIREAT", key, ARGV[i * 3])
+	end
+end
+values[#KEYS + 1] = allowed
+return values
+`)
+
+// AddUsage adds increments to their counters atomically, unless one would exceed its limit
+func (u *UsageCounter) AddUsage(ctx context.Context, increments []translation.UsageIncrement) ([]int64, bool, error) {
+	keys := make([]string, len(increments))
+	args := make([]interface{}, 0, len(increments)*3)
+	for i, increment := range increments {
+		keys[i] = increment.Key
+		args = append(args, increment.Amount, increment.Limit, increment.ExpiresAt.UnixMilli())
+	}
+
+	values, err := addUsageScript.Run(ctx, u.client, keys, args...).Int64Slice()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to add usage: %w", err)
+	}
+
+	return values[:len(increments)], values[len(increments)] == 1, nil
+}
+
+// GetUsage gets counter values, 0 for counters that don't exist
+func (u *UsageCounter) GetUsage(ctx context.Context, keys []string) ([]int64, error) {
+	values := make([]int64, len(keys))
+	if len(keys) == 0 {
+		return values, nil
+	}
+
+	results, err := u.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usage: %w", err)
+	}
+
+	for i, result := range results {
+		if value, ok := result.(string); ok {
+			values[i], _ = strconv.ParseInt(value, 10, 64)
+		}
+	}
+
+	return values, nil
+}
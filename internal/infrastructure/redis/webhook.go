@@ -0,0 +1,204 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"translation/internal/domain/translation"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// deliveryRetention is how long webhook deliveries are kept in the delivery log
+const deliveryRetention = 7 * 24 * time.Hour
+
+// SaveWebhook saves webhook to Redis
+func (r *Repository) SaveWebhook(ctx context.Context, webhook *translation.Webhook) error {
+	data, err := json.Marshal(webhook)
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook: %w", err)
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Set(ctx, fmt.Sprintf("webhook:%s", webhook.ID.String()), data, 0)
+	pipe.SAdd(ctx, "webhooks", webhook.ID.String())
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// GetWebhook gets webhook by ID from Redis
+func (r *Repository) GetWebhook(ctx context.Context, id uuid.UUID) (*translation.Webhook, error) {
+	data, err := r.client.Get(ctx, fmt.Sprintf("webhook:%s", id.String())).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, fmt.Errorf("webhook not found")
+		}
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	var webhook translation.Webhook
+	if err := json.Unmarshal(data, &webhook); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal webhook: %w", err)
+	}
+
+	return &webhook, nil
+}
+
+// GetWebhooks gets all webhooks from Redis
+func (r *Repository) GetWebhooks(ctx context.Context) ([]*translation.Webhook, error) {
+	ids, err := r.client.SMembers(ctx, "webhooks").Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook IDs: %w", err)
+	}
+
+	var webhooks []*translation.Webhook
+	for _, rawID := range ids {
+		id, err := uuid.Parse(rawID)
+		if err != nil {
+			continue // Skip problematic IDs
+		}
+
+		webhook, err := r.GetWebhook(ctx, id)
+		if err != nil {
+			continue // Skip problematic webhooks
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook deletes webhook from Redis
+func (r *Repository) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, fmt.Sprintf("webhook:%s", id.String()))
+	pipe.SRem(ctx, "webhooks", id.String())
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// SaveDelivery saves webhook delivery to Redis, indexes it and updates its schedule
+func (r *Repository) SaveDelivery(ctx context.Context, delivery *translation.WebhookDelivery) error {
+	data, err := json.Marshal(delivery)
+	if err != nil {
+		return fmt.Errorf("failed to marshal delivery: %w", err)
+	}
+
+	id := delivery.ID.String()
+	requestIndex := fmt.Sprintf("webhook_deliveries:request:%s", delivery.RequestID.String())
+
+	pipe := r.client.TxPipeline()
+	pipe.Set(ctx, fmt.Sprintf("webhook_delivery:%s", id), data, deliveryRetention)
+	pipe.SAdd(ctx, requestIndex, id)
+	pipe.Expire(ctx, requestIndex, deliveryRetention)
+	if delivery.WebhookID != nil {
+		webhookIndex := fmt.Sprintf("webhook_deliveries:webhook:%s", delivery.WebhookID.String())
+		pipe.SAdd(ctx, webhookIndex, id)
+		pipe.Expire(ctx, webhookIndex, deliveryRetention)
+	}
+	if delivery.NextAttemptAt != nil {
+		pipe.ZAdd(ctx, "webhook_delivery_schedule", redis.Z{
+			Score:  float64(delivery.NextAttemptAt.UnixMilli()),
+			Member: id,
+		})
+	} else {
+		pipe.ZRem(ctx, "webhook_delivery_schedule", id)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to save delivery: %w", err)
+	}
+
+	return nil
+}
+
+// GetDelivery gets webhook delivery by ID from Redis
+func (r *Repository) GetDelivery(ctx context.Context, id uuid.UUID) (*translation.WebhookDelivery, error) {
+	data, err := r.client.Get(ctx, fmt.Sprintf("webhook_delivery:%s", id.String())).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, fmt.Errorf("delivery not found")
+		}
+		return nil, fmt.Errorf("failed to get delivery: %w", err)
+	}
+
+	var delivery translation.WebhookDelivery
+	if err := json.Unmarshal(data, &delivery); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal delivery: %w", err)
+	}
+
+	return &delivery, nil
+}
+
+// GetDeliveries gets deliveries of request and/or webhook from Redis, at least one filter is required
+func (r *Repository) GetDeliveries(ctx context.Context, requestID *uuid.UUID, webhookID *uuid.UUID) ([]*translation.WebhookDelivery, error) {
+	var index string
+	switch {
+	case requestID != nil:
+		index = fmt.Sprintf("webhook_deliveries:request:%s", requestID.String())
+	case webhookID != nil:
+		index = fmt.Sprintf("webhook_deliveries:webhook:%s", webhookID.String())
+	default:
+		return nil, fmt.Errorf("request or webhook filter is required")
+	}
+
+	ids, err := r.client.SMembers(ctx, index).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delivery IDs: %w", err)
+	}
+
+	var deliveries []*translation.WebhookDelivery
+	for _, rawID := range ids {
+		id, err := uuid.Parse(rawID)
+		if err != nil {
+			continue // Skip problematic IDs
+		}
+
+		delivery, err := r.GetDelivery(ctx, id)
+		if err != nil {
+			continue // Skip expired deliveries
+		}
+		if webhookID != nil && (delivery.WebhookID == nil || *delivery.WebhookID != *webhookID) {
+			continue
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+// ClaimDueDeliveries unschedules and returns deliveries due at given time from Redis.
+// Removing a delivery from the schedule is the claim, so concurrent workers never attempt it twice.
+func (r *Repository) ClaimDueDeliveries(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error) {
+	ids, err := r.client.ZRangeByScore(ctx, "webhook_delivery_schedule", &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(now.UnixMilli(), 10),
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due deliveries: %w", err)
+	}
+
+	var claimed []uuid.UUID
+	for _, rawID := range ids {
+		removed, err := r.client.ZRem(ctx, "webhook_delivery_schedule", rawID).Result()
+		if err != nil {
+			return claimed, fmt.Errorf("failed to claim delivery: %w", err)
+		}
+		if removed == 0 {
+			continue // Claimed by another worker
+		}
+
+		id, err := uuid.Parse(rawID)
+		if err != nil {
+			continue // Skip problematic IDs
+		}
+		claimed = append(claimed, id)
+	}
+
+	return claimed, nil
+}
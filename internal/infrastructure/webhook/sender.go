@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// Sender represents service posting webhook payloads over HTTP
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender creates a new webhook sender with timeout per attempt. Receivers on loopback, private and
+// link-local addresses are refused unless they are in allowed networks.
+func NewSender(timeout time.Duration, allowedNetworks []netip.Prefix) *Sender {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		// Addresses are checked when connecting, after names are resolved, so names resolving to internal
+		// addresses are refused whenever they are looked up
+		Control: func(network, address string, _ syscall.RawConn) error {
+			return checkAddress(address, allowedNetworks)
+		},
+	}
+
+	return &Sender{
+		client: &http.Client{
+			Timeout: timeout,
+			// Proxies aren't used, they would connect to receivers without the check
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				ForceAttemptHTTP2:   true,
+				MaxIdleConns:        100,
+				IdleConnTimeout:     90 * time.Second,
+				TLSHandshakeTimeout: 10 * time.Second,
+			},
+			// Receivers must answer themselves, redirects are treated as failures
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Send posts JSON payload with headers to URL and returns response status code
+func (s *Sender) Send(ctx context.Context, url string, headers map[string]string, payload []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "translation-service-webhooks")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Drain body so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	return resp.StatusCode, nil
+}
+
+// checkAddress refuses connection to address on loopback, private, link-local, multicast or unspecified IP
+// outside allowed networks
+func checkAddress(address string, allowedNetworks []netip.Prefix) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid webhook address %s: %w", address, err)
+	}
+
+	ip := addrPort.Addr().Unmap()
+	for _, network := range allowedNetworks {
+		if network.Contains(ip) {
+			return nil
+		}
+	}
+
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("webhook address %s is not allowed", ip)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetQueueInfo returns state of task queue
+// @Summary Get task queue info
+// @Description Get number of tasks in the task queue of the configured backend. RabbitMQ and in-process queues count tasks waiting for a worker, Redis Streams also count tasks being processed
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} dto.GetQueueInfoResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/admin/queue [get]
+func (h *Handler) GetQueueInfo(c *fiber.Ctx) error {
+	queued, err := h.appService.GetQueueInfo()
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to get queue info: %v", err),
+		})
+	}
+
+	return c.JSON(dto.GetQueueInfoResponse{
+		Queued: queued,
+	})
+}
+
+// GetDeadLetters lists tasks that failed all attempts
+// @Summary List dead-lettered tasks
+// @Description Get tasks moved to the dead-letter queue after failing all attempts, oldest first, with the last error. Their requests are marked as failed
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Param limit query int false "Maximum number of tasks to return" default(100)
+// @Success 200 {object} dto.GetDeadLettersResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/admin/dead-letters [get]
+func (h *Handler) GetDeadLetters(c *fiber.Ctx) error {
+	limit, err := strconv.Atoi(c.Query("limit", "100"))
+	if err != nil || limit < 1 {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "limit must be a positive integer",
+		})
+	}
+
+	deadLetters, err := h.appService.GetDeadLetters(c.Context(), limit)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to get dead-lettered tasks: %v", err),
+		})
+	}
+
+	infos := make([]dto.DeadLetterInfo, 0, len(deadLetters))
+	for _, deadLetter := range deadLetters {
+		infos = append(infos, toDeadLetterInfo(deadLetter))
+	}
+
+	return c.JSON(dto.GetDeadLettersResponse{
+		DeadLetters: infos,
+		Count:       len(infos),
+	})
+}
+
+// RequeueDeadLetters processes requests of dead-lettered tasks again
+// @Summary Requeue dead-lettered tasks
+// @Description Reopen failed requests of dead-lettered tasks and queue them from the start; omit ids to requeue all tasks. Tasks of requests that are no longer failed are removed without requeueing and are counted too
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.RequeueDeadLettersRequest false "IDs of dead-lettered tasks"
+// @Success 200 {object} dto.RequeueDeadLettersResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/admin/dead-letters/requeue [post]
+func (h *Handler) RequeueDeadLetters(c *fiber.Ctx) error {
+	var req dto.RequeueDeadLettersRequest
+
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "Invalid request body",
+			})
+		}
+	}
+
+	requeued, err := h.appService.RequeueDeadLetters(c.Context(), req.IDs)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to requeue dead-lettered tasks (%d requeued): %v", requeued, err),
+		})
+	}
+
+	auditChange(c, "", "", nil, map[string]any{"ids": req.IDs, "requeued": requeued})
+
+	return c.JSON(dto.RequeueDeadLettersResponse{
+		Requeued: requeued,
+	})
+}
+
+// PurgeDeadLetters removes all dead-lettered tasks
+// @Summary Purge dead-lettered tasks
+// @Description Remove all tasks from the dead-letter queue, their requests stay failed
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} dto.PurgeDeadLettersResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/admin/dead-letters [delete]
+func (h *Handler) PurgeDeadLetters(c *fiber.Ctx) error {
+	purged, err := h.appService.PurgeDeadLetters(c.Context())
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to purge dead-lettered tasks: %v", err),
+		})
+	}
+
+	auditChange(c, "", "", nil, map[string]any{"purged": purged})
+
+	return c.JSON(dto.PurgeDeadLettersResponse{
+		Purged: purged,
+	})
+}
+
+// toDeadLetterInfo converts dead-lettered task to DTO
+func toDeadLetterInfo(deadLetter *domainTranslation.DeadLetter) dto.DeadLetterInfo {
+	info := dto.DeadLetterInfo{
+		ID:             deadLetter.ID,
+		Attempts:       deadLetter.Attempts,
+		Error:          deadLetter.Error,
+		DeadLetteredAt: deadLetter.DeadLetteredAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if task := deadLetter.Task; task != nil {
+		info.Task = &dto.DeadLetterTaskInfo{
+			RequestID: task.RequestID.String(),
+			Languages: task.Languages,
+			Chunk:     task.Chunk,
+			Keys:      task.Keys,
+		}
+		if task.PlanID != nil {
+			planID := task.PlanID.String()
+			info.Task.PlanID = &planID
+		}
+	}
+
+	return info
+}
@@ -0,0 +1,278 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CreateAPIKey creates managed API key
+// @Summary Create API key
+// @Description Create API key with permissions translate, read, cache:write or admin, optionally limited to projects and expiring. Project-scoped keys can't have cache:write or admin. The key is only returned here, only its hash is stored
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.CreateAPIKeyRequest true "API key name, projects, permissions and expiry"
+// @Success 201 {object} dto.APIKeyInfo
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/admin/api-keys [post]
+func (h *Handler) CreateAPIKey(c *fiber.Ctx) error {
+	var req dto.CreateAPIKeyRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Invalid request body",
+		})
+	}
+
+	options := domainTranslation.CreateAPIKeyOptions{
+		Name:     req.Name,
+		Projects: req.Projects,
+	}
+	for _, permission := range req.Permissions {
+		options.Permissions = append(options.Permissions, domainTranslation.Permission(permission))
+	}
+	if req.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339, req.ExpiresAt)
+		if err != nil {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "expires_at must be an RFC 3339 time",
+			})
+		}
+		options.ExpiresAt = &expiresAt
+	}
+
+	key, secret, err := h.appService.CreateAPIKey(c.Context(), principal(c), options)
+	if err != nil {
+		return apiKeyError(c, "create", err)
+	}
+	auditChange(c, key.ID, "", nil, apiKeySummary(key))
+
+	info := toAPIKeyInfo(key)
+	info.Key = secret
+
+	return c.Status(http.StatusCreated).JSON(info)
+}
+
+// GetAPIKeys lists managed API keys
+// @Summary List API keys
+// @Description Get managed API keys ordered by creation time, including revoked and expired ones. Keys themselves are never returned
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} dto.GetAPIKeysResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/admin/api-keys [get]
+func (h *Handler) GetAPIKeys(c *fiber.Ctx) error {
+	keys, err := h.appService.GetAPIKeys(c.Context())
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to get API keys: %v", err),
+		})
+	}
+
+	infos := make([]dto.APIKeyInfo, 0, len(keys))
+	for _, key := range keys {
+		infos = append(infos, toAPIKeyInfo(key))
+	}
+
+	return c.JSON(dto.GetAPIKeysResponse{
+		APIKeys: infos,
+		Count:   len(infos),
+	})
+}
+
+// GetAPIKey gets managed API key by ID
+// @Summary Get API key
+// @Description Get managed API key with its permissions, projects and last use
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "API key ID"
+// @Success 200 {object} dto.APIKeyInfo
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/admin/api-keys/{id} [get]
+func (h *Handler) GetAPIKey(c *fiber.Ctx) error {
+	key, err := h.appService.GetAPIKey(c.Context(), c.Params("id"))
+	if err != nil {
+		return apiKeyError(c, "get", err)
+	}
+
+	return c.JSON(toAPIKeyInfo(key))
+}
+
+// RotateAPIKey replaces managed API key with a new one
+// @Summary Rotate API key
+// @Description Create API key with the same name, projects, permissions and lifetime replacing the given key. The old key stays valid for overlap_seconds (24 hours by default, 0 to revoke it right away) so clients can switch. The new key is only returned here
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "API key ID"
+// @Param request body dto.RotateAPIKeyRequest false "How long the old key stays valid"
+// @Success 201 {object} dto.APIKeyInfo
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/admin/api-keys/{id}/rotate [post]
+func (h *Handler) RotateAPIKey(c *fiber.Ctx) error {
+	var req dto.RotateAPIKeyRequest
+
+	// Body is optional
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "Invalid request body",
+			})
+		}
+	}
+
+	overlap := domainTranslation.DefaultAPIKeyRotationOverlap
+	if req.OverlapSeconds != nil {
+		overlap = time.Duration(*req.OverlapSeconds) * time.Second
+	}
+
+	key, secret, err := h.appService.RotateAPIKey(c.Context(), principal(c), c.Params("id"), overlap)
+	if err != nil {
+		return apiKeyError(c, "rotate", err)
+	}
+	auditChange(c, key.RotatedFrom, "", nil, map[string]any{"replaced_by": key.ID})
+
+	info := toAPIKeyInfo(key)
+	info.Key = secret
+
+	return c.Status(http.StatusCreated).JSON(info)
+}
+
+// RevokeAPIKey revokes managed API key
+// @Summary Revoke API key
+// @Description Revoke API key so it can't be used anymore. Revoked keys are kept and listed for reference
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "API key ID"
+// @Success 200 {object} dto.APIKeyInfo
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/admin/api-keys/{id} [delete]
+func (h *Handler) RevokeAPIKey(c *fiber.Ctx) error {
+	key, err := h.appService.RevokeAPIKey(c.Context(), principal(c), c.Params("id"))
+	if err != nil {
+		return apiKeyError(c, "revoke", err)
+	}
+	auditChange(c, key.ID, "", nil, apiKeySummary(key))
+
+	return c.JSON(toAPIKeyInfo(key))
+}
+
+// apiKeyError maps error of API key operation to response
+func apiKeyError(c *fiber.Ctx, operation string, err error) error {
+	switch {
+	case err.Error() == "api key not found":
+		return c.Status(http.StatusNotFound).JSON(dto.ErrorResponse{
+			Error: "API key not found",
+		})
+	case err.Error() == "api key name is required":
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Name is required",
+		})
+	case err.Error() == "invalid permission":
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Permissions must be some of translate, read, cache:write and admin",
+		})
+	case strings.HasPrefix(err.Error(), "project-scoped api key cannot have permission"):
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Project-scoped API keys can't have cache:write or admin permission",
+		})
+	case err.Error() == "invalid expiry":
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "expires_at must be in the future",
+		})
+	case err.Error() == "invalid overlap":
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "overlap_seconds must not be negative",
+		})
+	case err.Error() == "api key cannot grant more access than issuer has":
+		return c.Status(http.StatusForbidden).JSON(dto.ErrorResponse{
+			Error: "API key can't grant more access than it has",
+		})
+	case err.Error() == "api key is not active":
+		return c.Status(http.StatusConflict).JSON(dto.ErrorResponse{
+			Error: "API key is revoked, expired or already rotated",
+		})
+	}
+
+	return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error: fmt.Sprintf("Failed to %s API key: %v", operation, err),
+	})
+}
+
+// apiKeySummary summarizes API key for audit log, without its secret
+func apiKeySummary(key *domainTranslation.APIKey) map[string]any {
+	return map[string]any{
+		"name":        key.Name,
+		"projects":    key.Projects,
+		"permissions": key.Permissions,
+		"expires_at":  key.ExpiresAt,
+		"revoked_at":  key.RevokedAt,
+	}
+}
+
+// toAPIKeyInfo converts domain API key to DTO without its secret
+func toAPIKeyInfo(key *domainTranslation.APIKey) dto.APIKeyInfo {
+	info := dto.APIKeyInfo{
+		ID:          key.ID,
+		Name:        key.Name,
+		Prefix:      key.Prefix,
+		Projects:    key.Projects,
+		Permissions: make([]string, 0, len(key.Permissions)),
+		Active:      key.Active(time.Now()),
+		CreatedAt:   key.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		RotatedFrom: key.RotatedFrom,
+		ReplacedBy:  key.ReplacedBy,
+	}
+
+	for _, permission := range key.Permissions {
+		info.Permissions = append(info.Permissions, string(permission))
+	}
+
+	if key.ExpiresAt != nil {
+		expiresAt := key.ExpiresAt.Format("2006-01-02T15:04:05Z")
+		info.ExpiresAt = &expiresAt
+	}
+	if key.RevokedAt != nil {
+		revokedAt := key.RevokedAt.Format("2006-01-02T15:04:05Z")
+		info.RevokedAt = &revokedAt
+	}
+	if key.LastUsedAt != nil {
+		lastUsedAt := key.LastUsedAt.Format("2006-01-02T15:04:05Z")
+		info.LastUsedAt = &lastUsedAt
+	}
+
+	return info
+}
@@ -0,0 +1,174 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+)
+
+// createAPIKey creates managed API key with bootstrap key and returns it with its secret
+func (e *testEnv) createAPIKey(req dto.CreateAPIKeyRequest) dto.APIKeyInfo {
+	e.t.Helper()
+
+	var resp dto.APIKeyInfo
+	if status := e.do(http.MethodPost, "/api/v1/admin/api-keys", req, &resp); status != http.StatusCreated {
+		e.t.Fatalf("expected status 201, got %d", status)
+	}
+	if resp.Key == "" {
+		e.t.Fatalf("expected key to be returned on creation")
+	}
+	return resp
+}
+
+func TestAPIKeyPermissionsAndProjects(t *testing.T) {
+	env := newTestEnv(t)
+
+	reader := env.createAPIKey(dto.CreateAPIKeyRequest{Name: "dashboard", Permissions: []string{"read"}})
+	mobile := env.createAPIKey(dto.CreateAPIKeyRequest{
+		Name:        "mobile-ci",
+		Projects:    []string{"mobile-app"},
+		Permissions: []string{"translate", "read"},
+	})
+
+	// Keys are limited to their permissions
+	body := dto.CreateTranslationRequestRequest{SourceData: map[string]string{"hello": "Hello"}, Languages: []string{"es"}, Project: "mobile-app"}
+	if status := env.doAs(reader.Key, http.MethodPost, "/api/v1/translations", body, nil); status != http.StatusForbidden {
+		t.Errorf("expected read key not to create requests, got status %d", status)
+	}
+	if status := env.doAs(reader.Key, http.MethodGet, "/api/v1/translations", nil, nil); status != http.StatusOK {
+		t.Errorf("expected read key to list requests, got status %d", status)
+	}
+	if status := env.doAs(mobile.Key, http.MethodGet, "/api/v1/admin/api-keys", nil, nil); status != http.StatusForbidden {
+		t.Errorf("expected key without admin permission not to manage keys, got status %d", status)
+	}
+
+	// Project-scoped keys only see requests of their projects
+	var created dto.CreateTranslationRequestResponse
+	if status := env.doAs(mobile.Key, http.MethodPost, "/api/v1/translations", body, &created); status != http.StatusCreated {
+		t.Fatalf("expected scoped key to create request of its project, got status %d", status)
+	}
+	body.Project = "web-app"
+	if status := env.doAs(mobile.Key, http.MethodPost, "/api/v1/translations", body, nil); status != http.StatusForbidden {
+		t.Errorf("expected scoped key not to create request of another project, got status %d", status)
+	}
+	web := env.createPriorityRequest("web-app", 0, map[string]string{"bye": "Bye"}, "fr")
+
+	if status := env.doAs(mobile.Key, http.MethodGet, "/api/v1/translations/"+web, nil, nil); status != http.StatusNotFound {
+		t.Errorf("expected request of another project to be hidden, got status %d", status)
+	}
+	if status := env.doAs(mobile.Key, http.MethodPost, "/api/v1/translations/"+web+"/cancel", nil, nil); status != http.StatusNotFound {
+		t.Errorf("expected request of another project not to be cancelled, got status %d", status)
+	}
+	var list dto.ListTranslationRequestsResponse
+	env.doAs(mobile.Key, http.MethodGet, "/api/v1/translations", nil, &list)
+	if got := requestIDs(list); len(got) != 1 || got[0] != created.RequestID || list.Requests[0].CreatedBy != mobile.ID {
+		t.Errorf("expected only request of own project, got %+v", list.Requests)
+	}
+	query := url.Values{"project": {"web-app"}}
+	if status := env.doAs(mobile.Key, http.MethodGet, "/api/v1/translations?"+query.Encode(), nil, nil); status != http.StatusForbidden {
+		t.Errorf("expected scoped key not to list another project, got status %d", status)
+	}
+
+	// Secrets are never listed, use is tracked
+	var keys dto.GetAPIKeysResponse
+	env.do(http.MethodGet, "/api/v1/admin/api-keys", nil, &keys)
+	if keys.Count != 2 || keys.APIKeys[0].ID != reader.ID || keys.APIKeys[1].ID != mobile.ID {
+		t.Fatalf("expected keys in creation order, got %+v", keys.APIKeys)
+	}
+	for _, key := range keys.APIKeys {
+		if key.Key != "" || key.LastUsedAt == nil || !key.Active {
+			t.Errorf("expected active used key without secret, got %+v", key)
+		}
+	}
+	stored, err := env.repo.GetAPIKey(context.Background(), mobile.ID)
+	if err != nil || stored.Hash != domainTranslation.HashAPIKey(mobile.Key) {
+		t.Errorf("expected only hash of key to be stored, got %+v (%v)", stored, err)
+	}
+
+	for _, req := range []dto.CreateAPIKeyRequest{
+		{Permissions: []string{"read"}},
+		{Name: "no-permissions"},
+		{Name: "unknown", Permissions: []string{"write"}},
+		{Name: "scoped-admin", Projects: []string{"mobile-app"}, Permissions: []string{"admin"}},
+		{Name: "expired", Permissions: []string{"read"}, ExpiresAt: time.Now().Add(-time.Hour).Format(time.RFC3339)},
+	} {
+		if status := env.do(http.MethodPost, "/api/v1/admin/api-keys", req, nil); status != http.StatusBadRequest {
+			t.Errorf("expected status 400 for %+v, got %d", req, status)
+		}
+	}
+}
+
+func TestAPIKeyRotationAndRevocation(t *testing.T) {
+	env := newTestEnv(t)
+	original := env.createAPIKey(dto.CreateAPIKeyRequest{Name: "backend", Permissions: []string{"read"}})
+
+	// Both keys work during overlap
+	overlap := 3600
+	var rotated dto.APIKeyInfo
+	if status := env.do(http.MethodPost, "/api/v1/admin/api-keys/"+original.ID+"/rotate", dto.RotateAPIKeyRequest{OverlapSeconds: &overlap}, &rotated); status != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d", status)
+	}
+	if rotated.Key == "" || rotated.RotatedFrom != original.ID || rotated.Name != original.Name {
+		t.Fatalf("expected new key replacing the original, got %+v", rotated)
+	}
+	for _, key := range []string{original.Key, rotated.Key} {
+		if status := env.doAs(key, http.MethodGet, "/api/v1/translations", nil, nil); status != http.StatusOK {
+			t.Errorf("expected both keys to work during overlap, got status %d", status)
+		}
+	}
+	var old dto.APIKeyInfo
+	env.do(http.MethodGet, "/api/v1/admin/api-keys/"+original.ID, nil, &old)
+	if old.ReplacedBy != rotated.ID || old.ExpiresAt == nil {
+		t.Errorf("expected original key to expire after overlap, got %+v", old)
+	}
+	if status := env.do(http.MethodPost, "/api/v1/admin/api-keys/"+original.ID+"/rotate", nil, nil); status != http.StatusConflict {
+		t.Errorf("expected rotated key not to be rotated again, got status %d", status)
+	}
+
+	// Without overlap the old key stops working right away
+	overlap = 0
+	var replacement dto.APIKeyInfo
+	env.do(http.MethodPost, "/api/v1/admin/api-keys/"+rotated.ID+"/rotate", dto.RotateAPIKeyRequest{OverlapSeconds: &overlap}, &replacement)
+	if status := env.doAs(rotated.Key, http.MethodGet, "/api/v1/translations", nil, nil); status != http.StatusUnauthorized {
+		t.Errorf("expected key rotated without overlap to be rejected, got status %d", status)
+	}
+
+	// Revoked keys are rejected but kept
+	var revoked dto.APIKeyInfo
+	if status := env.do(http.MethodDelete, "/api/v1/admin/api-keys/"+replacement.ID, nil, &revoked); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if revoked.RevokedAt == nil || revoked.Active {
+		t.Errorf("expected revoked key, got %+v", revoked)
+	}
+	if status := env.doAs(replacement.Key, http.MethodGet, "/api/v1/translations", nil, nil); status != http.StatusUnauthorized {
+		t.Errorf("expected revoked key to be rejected, got status %d", status)
+	}
+
+	// Expired keys are rejected
+	expiresAt := time.Now().Add(-time.Minute)
+	secret := "tk_000000000000_expired"
+	err := env.repo.SaveAPIKey(context.Background(), &domainTranslation.APIKey{
+		ID:          "key_000000000000",
+		Name:        "expired",
+		Hash:        domainTranslation.HashAPIKey(secret),
+		Permissions: []domainTranslation.Permission{domainTranslation.PermissionRead},
+		CreatedAt:   expiresAt.Add(-time.Hour),
+		ExpiresAt:   &expiresAt,
+	})
+	if err != nil {
+		t.Fatalf("failed to save key: %v", err)
+	}
+	if status := env.doAs(secret, http.MethodGet, "/api/v1/translations", nil, nil); status != http.StatusUnauthorized {
+		t.Errorf("expected expired key to be rejected, got status %d", status)
+	}
+
+	if status := env.do(http.MethodGet, "/api/v1/admin/api-keys/key_ffffffffffff", nil, nil); status != http.StatusNotFound {
+		t.Errorf("expected status 404 for unknown key, got %d", status)
+	}
+}
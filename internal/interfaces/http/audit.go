@@ -0,0 +1,302 @@
+package http
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"translation/internal/application/translation"
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/google/uuid"
+)
+
+const (
+	// actionLocal is name of request local holding action the request was authorized for
+	actionLocal = "action"
+
+	// auditLocal is name of request local holding change handler made, recorded in audit log
+	auditLocal = "audit"
+)
+
+// auditedMethods are HTTP methods of requests recorded in audit log
+var auditedMethods = map[string]bool{
+	fiber.MethodPost:   true,
+	fiber.MethodPut:    true,
+	fiber.MethodPatch:  true,
+	fiber.MethodDelete: true,
+}
+
+// auditRecord describes change handler made for its audit entry
+type auditRecord struct {
+	target  string
+	project string
+	before  map[string]any
+	after   map[string]any
+}
+
+// auditChange describes change of target in project for audit entry of request. Before and after summarize
+// target, nil when there is nothing to summarize.
+func auditChange(c *fiber.Ctx, target string, project string, before map[string]any, after map[string]any) {
+	c.Locals(auditLocal, &auditRecord{target: target, project: project, before: before, after: after})
+}
+
+// Audit creates middleware appending mutating requests of API keys and users to audit log, including
+// refused ones. Target defaults to id, key or name parameter of the route when handler doesn't describe
+// its change. Failure to record doesn't fail the request, which already took effect.
+func Audit(appService *translation.Service) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		p := principal(c)
+		if p == nil || !auditedMethods[c.Method()] {
+			return c.Next()
+		}
+
+		handlerErr := c.Next()
+
+		status := c.Response().StatusCode()
+		var fiberErr *fiber.Error
+		if errors.As(handlerErr, &fiberErr) {
+			status = fiberErr.Code
+		} else if handlerErr != nil {
+			status = http.StatusInternalServerError
+		}
+
+		// Fiber reuses memory of request values, entry keeps copies
+		entry := &domainTranslation.AuditEntry{
+			Actor:     p.ID,
+			ActorName: p.Name,
+			Method:    utils.CopyString(c.Method()),
+			Path:      utils.CopyString(c.Path()),
+			IP:        utils.CopyString(c.IP()),
+			Status:    status,
+		}
+		entry.Action, _ = c.Locals(actionLocal).(domainTranslation.Action)
+		if record, ok := c.Locals(auditLocal).(*auditRecord); ok {
+			entry.Target = record.target
+			entry.Project = record.project
+			entry.Before = record.before
+			entry.After = record.after
+		}
+		for _, param := range []string{"id", "key", "name"} {
+			if entry.Target == "" {
+				entry.Target = c.Params(param)
+			}
+		}
+		entry.Target = utils.CopyString(entry.Target)
+		entry.Project = utils.CopyString(entry.Project)
+
+		if err := appService.RecordAuditEntry(c.Context(), entry); err != nil {
+			log.Printf("Failed to record audit entry of %s %s by %s: %v", entry.Method, entry.Path, entry.Actor, err)
+		}
+
+		return handlerErr
+	}
+}
+
+// auditedRequest returns project and summary of request before handler changes it, empty when request
+// can't be found
+func (h *Handler) auditedRequest(c *fiber.Ctx, id uuid.UUID) (string, map[string]any) {
+	request, err := h.appService.GetTranslationRequest(c.Context(), id)
+	if err != nil {
+		return "", nil
+	}
+	return request.Project, map[string]any{"status": string(request.Status)}
+}
+
+// overwrittenTranslations returns stored translations by language and key that caching translations
+// changes. Keys without English translation aren't cached and are left out.
+func (h *Handler) overwrittenTranslations(c *fiber.Ctx, translations map[string]map[string]string) map[string]map[string]string {
+	overwritten := make(map[string]map[string]string)
+	for keyName := range translations["en"] {
+		key, err := h.appService.GetTranslationKey(c.Context(), keyName)
+		if err != nil {
+			continue // New keys overwrite nothing
+		}
+
+		for lang, values := range translations {
+			value, cached := values[keyName]
+			previous, stored := key.Translations[lang]
+			if lang == "en" {
+				// English translation replaces source value
+				previous, stored = key.Value, true
+			}
+			if !cached || !stored || previous == value {
+				continue
+			}
+
+			if overwritten[lang] == nil {
+				overwritten[lang] = make(map[string]string)
+			}
+			overwritten[lang][keyName] = previous
+		}
+	}
+	return overwritten
+}
+
+// auditFilter parses audit log filters from query. Reports false after responding with error when they are
+// invalid.
+func auditFilter(c *fiber.Ctx) (domainTranslation.AuditFilter, bool, error) {
+	filter := domainTranslation.AuditFilter{
+		Actor:   c.Query("actor"),
+		Action:  domainTranslation.Action(c.Query("action")),
+		Target:  c.Query("target"),
+		Project: c.Query("project"),
+	}
+
+	var err error
+	if param := c.Query("from"); param != "" {
+		if filter.From, err = time.Parse(time.RFC3339, param); err != nil {
+			return filter, false, c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "from must be in RFC 3339 format",
+			})
+		}
+	}
+	if param := c.Query("to"); param != "" {
+		if filter.To, err = time.Parse(time.RFC3339, param); err != nil {
+			return filter, false, c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "to must be in RFC 3339 format",
+			})
+		}
+	}
+
+	return filter, true, nil
+}
+
+// ListAuditEntries lists audit log
+// @Summary List audit log
+// @Description List recorded mutating requests matching filters, newest first, one page at a time. Every POST, PUT, PATCH and DELETE request of an API key or user is recorded with its actor, authorized action, target, summaries of the target before and after the change, client IP and response status, including refused requests
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Param actor query string false "ID of API key or user that made requests"
+// @Param action query string false "Authorized action of requests, such as translations:delete"
+// @Param target query string false "Changed target, such as request ID, key, release name or webhook ID"
+// @Param project query string false "Project of changed target"
+// @Param from query string false "RFC 3339 timestamp of the earliest entry"
+// @Param to query string false "RFC 3339 timestamp of the latest entry"
+// @Param offset query int false "Number of entries to skip" default(0)
+// @Param limit query int false "Maximum number of entries to return, up to 1000" default(100)
+// @Success 200 {object} dto.ListAuditEntriesResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/admin/audit [get]
+func (h *Handler) ListAuditEntries(c *fiber.Ctx) error {
+	filter, ok, err := auditFilter(c)
+	if !ok {
+		return err
+	}
+
+	if filter.Offset, err = strconv.Atoi(c.Query("offset", "0")); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("offset must be non-negative and limit an integer from 1 to %d", domainTranslation.MaxAuditPageSize),
+		})
+	}
+	if filter.Limit, err = strconv.Atoi(c.Query("limit", strconv.Itoa(domainTranslation.DefaultAuditPageSize))); err != nil || filter.Limit < 1 {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("offset must be non-negative and limit an integer from 1 to %d", domainTranslation.MaxAuditPageSize),
+		})
+	}
+
+	page, err := h.appService.ListAuditEntries(c.Context(), filter)
+	if err != nil {
+		if err.Error() == "invalid page" {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: fmt.Sprintf("offset must be non-negative and limit an integer from 1 to %d", domainTranslation.MaxAuditPageSize),
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to list audit log: %v", err),
+		})
+	}
+
+	entries := make([]dto.AuditEntryInfo, 0, len(page.Entries))
+	for _, entry := range page.Entries {
+		entries = append(entries, toAuditEntryInfo(entry))
+	}
+
+	return c.JSON(dto.ListAuditEntriesResponse{
+		Entries: entries,
+		Count:   len(entries),
+		Total:   page.Total,
+		Offset:  filter.Offset,
+		Limit:   filter.Limit,
+	})
+}
+
+// ExportAuditEntries exports audit log as JSON Lines
+// @Summary Export audit log
+// @Description Export all recorded mutating requests matching filters as JSON Lines, oldest first, one entry per line in the format of listed entries. Entries are streamed as they are read, so a failure while exporting ends the export early
+// @Tags admin
+// @Produce application/x-ndjson
+// @Security ApiKeyAuth
+// @Param actor query string false "ID of API key or user that made requests"
+// @Param action query string false "Authorized action of requests, such as translations:delete"
+// @Param target query string false "Changed target, such as request ID, key, release name or webhook ID"
+// @Param project query string false "Project of changed target"
+// @Param from query string false "RFC 3339 timestamp of the earliest entry"
+// @Param to query string false "RFC 3339 timestamp of the latest entry"
+// @Success 200 {string} string "Audit entries, one JSON object per line"
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Router /api/v1/admin/audit/export [get]
+func (h *Handler) ExportAuditEntries(c *fiber.Ctx) error {
+	filter, ok, err := auditFilter(c)
+	if !ok {
+		return err
+	}
+
+	c.Set("Content-Type", "application/x-ndjson")
+	c.Set("Content-Disposition", `attachment; filename="audit.jsonl"`)
+
+	// Entries are read and written a batch at a time, once streaming started a failure can only end the export
+	ctx := c.Context()
+	ctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		// Encoder terminates every entry with a newline
+		encoder := json.NewEncoder(w)
+		err := h.appService.ExportAuditEntries(ctx, filter, func(entries []*domainTranslation.AuditEntry) error {
+			for _, entry := range entries {
+				if err := encoder.Encode(toAuditEntryInfo(entry)); err != nil {
+					return err
+				}
+			}
+			return w.Flush()
+		})
+		if err != nil {
+			log.Printf("Failed to export audit log: %v", err)
+		}
+	})
+
+	return nil
+}
+
+// toAuditEntryInfo converts audit entry to DTO format
+func toAuditEntryInfo(entry *domainTranslation.AuditEntry) dto.AuditEntryInfo {
+	return dto.AuditEntryInfo{
+		ID:        entry.ID.String(),
+		Actor:     entry.Actor,
+		ActorName: entry.ActorName,
+		Action:    string(entry.Action),
+		Method:    entry.Method,
+		Path:      entry.Path,
+		Target:    entry.Target,
+		Project:   entry.Project,
+		Before:    entry.Before,
+		After:     entry.After,
+		IP:        entry.IP,
+		Status:    entry.Status,
+		CreatedAt: entry.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
@@ -0,0 +1,170 @@
+package http_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	domainTranslation "translation/internal/domain/translation"
+	httpInterface "translation/internal/interfaces/http"
+	"translation/internal/interfaces/http/dto"
+
+	"github.com/google/uuid"
+)
+
+// listAudit lists audit log matching query
+func (e *testEnv) listAudit(query url.Values) dto.ListAuditEntriesResponse {
+	e.t.Helper()
+
+	var resp dto.ListAuditEntriesResponse
+	if status := e.do(http.MethodGet, "/api/v1/admin/audit?"+query.Encode(), nil, &resp); status != http.StatusOK {
+		e.t.Fatalf("expected status 200, got %d", status)
+	}
+	return resp
+}
+
+func TestAuditLog(t *testing.T) {
+	env := newTestEnv(t)
+	admin := httpInterface.APIKeyID(testAPIKey)
+
+	env.cacheTranslations(map[string]map[string]string{"en": {"bye": "Bye", "hi": "Hi"}, "es": {"bye": "Adiós", "hi": "Hola"}})
+	env.cacheTranslations(map[string]map[string]string{"en": {"bye": "Bye"}, "es": {"bye": "Chao"}})
+	id := env.createPriorityRequest("mobile-app", 0, map[string]string{"hello": "Hello"}, "es")
+	env.cancelRequest(id, dto.CancelTranslationRequestRequest{})
+	if status := env.do(http.MethodDelete, "/api/v1/translations/bye", nil, nil); status != http.StatusNoContent {
+		t.Fatalf("expected status 204, got %d", status)
+	}
+
+	// Refused requests are recorded too, reads aren't
+	reader := env.createAPIKey(dto.CreateAPIKeyRequest{Name: "reader", Permissions: []string{"read"}})
+	if status := env.doAs(reader.Key, http.MethodDelete, "/api/v1/translations/hi", nil, nil); status != http.StatusForbidden {
+		t.Fatalf("expected status 403, got %d", status)
+	}
+	env.doAs(reader.Key, http.MethodGet, "/api/v1/translations/"+id, nil, nil)
+
+	all := env.listAudit(nil)
+	if all.Total != 7 {
+		t.Fatalf("expected 7 mutating requests recorded, got %d: %+v", all.Total, all.Entries)
+	}
+	if latest := all.Entries[0]; latest.Actor != reader.ID || latest.ActorName != "reader" || latest.Status != http.StatusForbidden ||
+		latest.Action != "translations:delete" || latest.Target != "hi" || latest.Method != http.MethodDelete || latest.IP == "" {
+		t.Errorf("expected refused deletion listed first, got %+v", latest)
+	}
+
+	deleted := env.listAudit(url.Values{"action": {"translations:delete"}, "actor": {admin}})
+	if deleted.Total != 1 {
+		t.Fatalf("expected one deletion by admin, got %+v", deleted.Entries)
+	}
+	if entry := deleted.Entries[0]; entry.Target != "bye" || entry.Status != http.StatusNoContent || entry.Before["value"] != "Bye" || entry.After != nil {
+		t.Errorf("expected deleted key summarized before deletion, got %+v", entry)
+	}
+
+	cached := env.listAudit(url.Values{"action": {"translations:write"}})
+	if cached.Total != 2 || cached.Entries[1].Before != nil {
+		t.Fatalf("expected two cache writes, the first overwriting nothing, got %+v", cached.Entries)
+	}
+	overwritten, _ := cached.Entries[0].Before["translations"].(map[string]any)
+	if es, _ := overwritten["es"].(map[string]any); len(overwritten) != 1 || es["bye"] != "Adiós" {
+		t.Errorf("expected overwritten translation kept, got %+v", cached.Entries[0].Before)
+	}
+
+	cancelled := env.listAudit(url.Values{"target": {id}, "project": {"mobile-app"}})
+	if cancelled.Total != 2 {
+		t.Fatalf("expected creation and cancellation of request, got %+v", cancelled.Entries)
+	}
+	if entry := cancelled.Entries[0]; entry.Action != "requests:cancel" || entry.Before["status"] != "pending" || entry.After["status"] != "cancelled" {
+		t.Errorf("expected cancellation with status before and after, got %+v", entry)
+	}
+
+	page := env.listAudit(url.Values{"offset": {"1"}, "limit": {"2"}})
+	if page.Count != 2 || page.Total != 7 || page.Entries[0].ID != all.Entries[1].ID {
+		t.Errorf("expected second page of two entries, got %+v", page)
+	}
+	if status := env.do(http.MethodGet, "/api/v1/admin/audit?from=yesterday", nil, nil); status != http.StatusBadRequest {
+		t.Errorf("expected invalid time to be rejected, got status %d", status)
+	}
+
+	// Only admins read the audit log
+	if status := env.doAs(reader.Key, http.MethodGet, "/api/v1/admin/audit", nil, nil); status != http.StatusForbidden {
+		t.Errorf("expected reader not to list audit log, got status %d", status)
+	}
+
+	resp := env.doWithHeaders(http.MethodGet, "/api/v1/admin/audit/export?actor="+admin, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Errorf("expected JSON Lines, got %q", ct)
+	}
+	var exported []dto.AuditEntryInfo
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var entry dto.AuditEntryInfo
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("failed to decode line %q: %v", scanner.Text(), err)
+		}
+		exported = append(exported, entry)
+	}
+	if len(exported) != 6 || exported[0].Action != "translations:write" || exported[5].Action != "api_keys:manage" {
+		t.Errorf("expected entries of admin oldest first, got %+v", exported)
+	}
+}
+
+func TestAuditLogPagesLargeLog(t *testing.T) {
+	env := newTestEnv(t)
+
+	// More entries than are read from storage at once, alternating between two actors
+	started := time.Now().Add(-time.Hour)
+	for i := 0; i < 1100; i++ {
+		actor := "alice"
+		if i%2 == 1 {
+			actor = "bob"
+		}
+		env.repo.AppendAuditEntry(context.Background(), &domainTranslation.AuditEntry{
+			ID:        uuid.New(),
+			Actor:     actor,
+			Method:    http.MethodPost,
+			Path:      fmt.Sprintf("/entries/%d", i),
+			Status:    http.StatusOK,
+			CreatedAt: started.Add(time.Duration(i) * time.Millisecond),
+		})
+	}
+
+	all := env.listAudit(url.Values{"limit": {"2"}})
+	if all.Total != 1100 || len(all.Entries) != 2 || all.Entries[0].Path != "/entries/1099" {
+		t.Errorf("expected newest of 1100 entries first, got total %d and %+v", all.Total, all.Entries)
+	}
+
+	// Page of filtered entries spans entries read in different batches
+	page := env.listAudit(url.Values{"actor": {"bob"}, "offset": {"499"}, "limit": {"3"}})
+	if page.Total != 550 || len(page.Entries) != 3 {
+		t.Fatalf("expected 3 of 550 entries of bob, got total %d and %d entries", page.Total, len(page.Entries))
+	}
+	for i, want := range []string{"/entries/101", "/entries/99", "/entries/97"} {
+		if page.Entries[i].Path != want {
+			t.Errorf("expected entry %d of page to be %s, got %s", i, want, page.Entries[i].Path)
+		}
+	}
+
+	resp := env.doWithHeaders(http.MethodGet, "/api/v1/admin/audit/export?actor=alice", nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+	var exported []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var entry dto.AuditEntryInfo
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("failed to decode line %q: %v", scanner.Text(), err)
+		}
+		exported = append(exported, entry.Path)
+	}
+	if len(exported) != 550 || exported[0] != "/entries/0" || exported[549] != "/entries/1098" {
+		t.Errorf("expected all 550 entries of alice oldest first, got %d", len(exported))
+	}
+}
@@ -0,0 +1,201 @@
+package http_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"translation/internal/config"
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+
+	"github.com/google/uuid"
+)
+
+// cancelRequest cancels translation request with given options
+func (e *testEnv) cancelRequest(id string, req dto.CancelTranslationRequestRequest) dto.CancelTranslationRequestResponse {
+	e.t.Helper()
+
+	var resp dto.CancelTranslationRequestResponse
+	if status := e.do(http.MethodPost, "/api/v1/translations/"+id+"/cancel", req, &resp); status != http.StatusOK {
+		e.t.Fatalf("expected status 200, got %d", status)
+	}
+	return resp
+}
+
+// waitForTranslated waits until request has count keys translated
+func (e *testEnv) waitForTranslated(id string, count int) {
+	e.t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if resp := e.getRequest(id); resp.Progress != nil && resp.Progress.Done >= count {
+			return
+		}
+		if time.Now().After(deadline) {
+			e.t.Fatalf("request %s did not translate %d keys", id, count)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestCancelAbortsTranslationInProgress(t *testing.T) {
+	env := newTestEnv(t)
+	env.translator.SetDelay(10 * time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := env.runConsumer(ctx)
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	env.waitForTranslations(1)
+	env.cancelRequest(id, dto.CancelTranslationRequestRequest{})
+
+	// The consumer waits for tasks in progress, so it only stops quickly if the call was aborted
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("translation in progress was not aborted")
+	}
+
+	resp := env.getRequest(id)
+	if resp.Status != string(domainTranslation.StatusCancelled) {
+		t.Errorf("expected request to stay cancelled, got %s", resp.Status)
+	}
+	// Aborted key is neither translated nor failed
+	if len(resp.Results) != 0 {
+		t.Errorf("expected no key results, got %+v", resp.Results)
+	}
+	if stored := env.storedKey("hello"); stored == nil || stored.Translations["es"] != "" {
+		t.Errorf("expected aborted translation not to be saved, got %+v", stored)
+	}
+}
+
+func TestCancelKeepsTranslationsByDefault(t *testing.T) {
+	env := newTestEnv(t)
+	env.workers = config.WorkerConfig{Concurrency: 1, ChunkSize: 1}
+	env.restart()
+	env.translator.SetDelay(50 * time.Millisecond)
+	env.startConsumer()
+
+	id := env.createRequest(map[string]string{"a": "A", "b": "B", "c": "C", "d": "D"}, "es")
+	env.waitForTranslated(id, 1)
+
+	if resp := env.cancelRequest(id, dto.CancelTranslationRequestRequest{}); resp.Discarded != 0 {
+		t.Errorf("expected no discarded translations, got %d", resp.Discarded)
+	}
+
+	resp := env.getRequest(id)
+	if resp.Progress.Done == 0 {
+		t.Fatal("expected translated keys to be kept")
+	}
+	for _, result := range resp.Results {
+		if result.Outcome != string(domainTranslation.KeyOutcomeTranslated) {
+			continue
+		}
+		if stored := env.storedKey(result.Key); stored == nil || stored.Translations["es"] == "" {
+			t.Errorf("expected translation of key %s to be kept", result.Key)
+		}
+	}
+}
+
+func TestCancelDiscardsTranslations(t *testing.T) {
+	env := newTestEnv(t)
+	env.workers = config.WorkerConfig{Concurrency: 1, ChunkSize: 1}
+	env.restart()
+	env.translator.SetDelay(50 * time.Millisecond)
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"cached": "Cached"},
+		"es": {"cached": "En caché"},
+	})
+	env.startConsumer()
+
+	id := env.createRequest(map[string]string{"cached": "Cached", "a": "A", "b": "B", "c": "C", "d": "D"}, "es")
+	env.waitForTranslated(id, 1)
+
+	cancelResp := env.cancelRequest(id, dto.CancelTranslationRequestRequest{DiscardTranslations: true})
+	if cancelResp.Discarded == 0 {
+		t.Fatal("expected translations to be discarded")
+	}
+
+	// Let chunks in progress stop
+	time.Sleep(100 * time.Millisecond)
+
+	resp := env.getRequest(id)
+	if resp.Progress.Discarded != cancelResp.Discarded || resp.Progress.Done != 0 {
+		t.Errorf("expected %d discarded and no translated keys, got %+v", cancelResp.Discarded, resp.Progress.LanguageProgressInfo)
+	}
+
+	for _, result := range resp.Results {
+		if result.Outcome != string(domainTranslation.KeyOutcomeDiscarded) {
+			continue
+		}
+		if stored := env.storedKey(result.Key); stored == nil || stored.Translations["es"] != "" {
+			t.Errorf("expected translation of key %s to be removed, got %+v", result.Key, stored)
+		}
+
+		history, err := env.repo.GetKeyHistory(context.Background(), result.Key)
+		if err != nil {
+			t.Fatalf("failed to get history: %v", err)
+		}
+		last := history[len(history)-1]
+		if last.Action != domainTranslation.HistoryActionDeleted || last.Source.RequestID == nil || last.Source.RequestID.String() != id {
+			t.Errorf("expected removal of key %s attributed to request in history, got %+v", result.Key, last)
+		}
+	}
+
+	// Translations the request reused from cache are not its own
+	if stored := env.storedKey("cached"); stored == nil || stored.Translations["es"] != "En caché" {
+		t.Errorf("expected cached translation to be kept, got %+v", stored)
+	}
+}
+
+func TestCancelRejectsFinishedAndUnknownRequests(t *testing.T) {
+	env := newTestEnv(t)
+	env.translator.FailLanguage("de", errors.New("rate limit exceeded"))
+	env.startConsumer()
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es", "de")
+	env.waitForStatus(id, domainTranslation.StatusPartiallyCompleted)
+
+	if status := env.do(http.MethodPost, "/api/v1/translations/"+id+"/cancel", nil, nil); status != http.StatusConflict {
+		t.Errorf("expected status 409 for partially completed request, got %d", status)
+	}
+	if status := env.do(http.MethodPost, "/api/v1/translations/00000000-0000-0000-0000-000000000000/cancel", nil, nil); status != http.StatusNotFound {
+		t.Errorf("expected status 404 for unknown request, got %d", status)
+	}
+}
+
+// cancelOnFinish cancels request right before it is finished, as if cancellation raced its last chunk
+type cancelOnFinish struct {
+	domainTranslation.Repository
+}
+
+func (s *cancelOnFinish) FinishRequest(ctx context.Context, id uuid.UUID, finish func(*domainTranslation.TranslationRequest)) (bool, error) {
+	if err := s.Repository.UpdateRequestStatus(ctx, id, domainTranslation.StatusCancelled); err != nil {
+		return false, err
+	}
+	return s.Repository.FinishRequest(ctx, id, finish)
+}
+
+func TestCancelBeforeCompletionKeepsRequestCancelled(t *testing.T) {
+	env := newTestEnv(t)
+	env.storage = &cancelOnFinish{Repository: env.repo}
+	env.restart()
+	env.startConsumer()
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	env.waitForTranslations(1)
+
+	// Completion must not overwrite the cancellation that landed after the last chunk
+	resp := env.waitForStatus(id, domainTranslation.StatusCancelled)
+	time.Sleep(50 * time.Millisecond)
+	if resp = env.getRequest(id); resp.Status != string(domainTranslation.StatusCancelled) {
+		t.Errorf("expected request to stay cancelled, got %s", resp.Status)
+	}
+	if resp.CompletedAt != nil {
+		t.Errorf("expected cancelled request to have no completion time, got %v", resp.CompletedAt)
+	}
+}
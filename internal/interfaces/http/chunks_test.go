@@ -0,0 +1,112 @@
+package http_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"translation/internal/config"
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/infrastructure/memory"
+)
+
+func TestRequestIsFannedOutIntoChunks(t *testing.T) {
+	env := newTestEnv(t)
+	env.workers = config.WorkerConfig{Concurrency: 4, ChunkSize: 2}
+	env.restart()
+	env.translator.SetDelay(10 * time.Millisecond)
+	env.startConsumer()
+
+	sourceData := map[string]string{}
+	for i := 1; i <= 5; i++ {
+		sourceData[fmt.Sprintf("key%d", i)] = fmt.Sprintf("Text %d", i)
+	}
+
+	// 3 languages with 3 chunks of at most 2 keys each
+	id := env.createRequest(sourceData, "es", "fr", "de")
+	resp := env.waitForStatus(id, domainTranslation.StatusCompleted)
+
+	for key, value := range sourceData {
+		for _, lang := range []string{"es", "fr", "de"} {
+			if got, want := resp.TranslatedData[lang][key], memory.FakeTranslation(value, lang); got != want {
+				t.Errorf("translation %s/%s = %q, want %q", lang, key, got, want)
+			}
+		}
+
+		// Chunks of different languages update the same key concurrently without losing translations
+		if stored := env.storedKey(key); stored == nil || len(stored.Translations) != 3 {
+			t.Errorf("expected key %s to keep all 3 translations, got %+v", key, stored)
+		}
+	}
+
+	if calls := env.translator.Calls(); calls != 15 {
+		t.Errorf("expected 15 translator calls, got %d", calls)
+	}
+	if concurrent := env.translator.MaxConcurrentCalls(); concurrent < 2 {
+		t.Errorf("expected chunks to be translated in parallel, max concurrent calls %d", concurrent)
+	}
+	if resp.Progress == nil || resp.Progress.Done != 15 || resp.Progress.Pending != 0 {
+		t.Errorf("unexpected progress: %+v", resp.Progress)
+	}
+}
+
+func TestChunksOnlyCoverMissingLanguages(t *testing.T) {
+	env := newTestEnv(t)
+	env.workers = config.WorkerConfig{Concurrency: 2, ChunkSize: 1}
+	env.restart()
+	env.startConsumer()
+
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello"},
+		"es": {"hello": "Hola"},
+	})
+
+	id := env.createRequest(map[string]string{"hello": "Hello", "bye": "Bye"}, "es", "fr")
+	resp := env.waitForStatus(id, domainTranslation.StatusCompleted)
+
+	if calls := env.translator.Calls(); calls != 3 {
+		t.Errorf("expected only missing languages to be translated, got %d translator calls", calls)
+	}
+	if resp.TranslatedData["es"]["hello"] != "Hola" {
+		t.Errorf("expected cached translation to be kept, got %q", resp.TranslatedData["es"]["hello"])
+	}
+	if result := resultOf(resp, "hello", "es"); result == nil || result.Outcome != string(domainTranslation.KeyOutcomeCached) {
+		t.Errorf("expected hello/es to be reused from cache, got %+v", result)
+	}
+	if result := resultOf(resp, "hello", "fr"); result == nil || result.Outcome != string(domainTranslation.KeyOutcomeTranslated) {
+		t.Errorf("expected hello/fr to be translated, got %+v", result)
+	}
+}
+
+func TestCancelStopsRemainingChunks(t *testing.T) {
+	env := newTestEnv(t)
+	env.workers = config.WorkerConfig{Concurrency: 1, ChunkSize: 1}
+	env.restart()
+	env.translator.SetDelay(50 * time.Millisecond)
+	env.startConsumer()
+
+	id := env.createRequest(map[string]string{"a": "A", "b": "B", "c": "C", "d": "D"}, "es")
+
+	deadline := time.Now().Add(5 * time.Second)
+	for env.translator.Calls() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("translation did not start")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if status := env.do(http.MethodPost, "/api/v1/translations/"+id+"/cancel", nil, nil); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+
+	// Let remaining chunks be picked up and skipped
+	time.Sleep(200 * time.Millisecond)
+
+	if resp := env.getRequest(id); resp.Status != string(domainTranslation.StatusCancelled) {
+		t.Errorf("expected request to stay cancelled, got %s", resp.Status)
+	}
+	if calls := env.translator.Calls(); calls > 2 {
+		t.Errorf("expected remaining chunks to be skipped, got %d translator calls", calls)
+	}
+}
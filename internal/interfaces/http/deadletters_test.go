@@ -0,0 +1,164 @@
+package http_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+
+	"github.com/google/uuid"
+)
+
+// failingStorage fails saving chunk plans while broken is set, so request tasks keep failing
+type failingStorage struct {
+	domainTranslation.Repository
+	broken atomic.Bool
+	calls  atomic.Int32
+}
+
+func (s *failingStorage) SaveChunkPlan(ctx context.Context, requestID uuid.UUID, planID uuid.UUID, total int) error {
+	s.calls.Add(1)
+	if s.broken.Load() {
+		return errors.New("storage unavailable")
+	}
+	return s.Repository.SaveChunkPlan(ctx, requestID, planID, total)
+}
+
+// useFailingStorage makes services save chunk plans through broken storage
+func (e *testEnv) useFailingStorage() *failingStorage {
+	storage := &failingStorage{Repository: e.repo}
+	storage.broken.Store(true)
+	e.storage = storage
+	e.restart()
+	return storage
+}
+
+// getDeadLetters lists dead-lettered tasks
+func (e *testEnv) getDeadLetters() dto.GetDeadLettersResponse {
+	e.t.Helper()
+
+	var resp dto.GetDeadLettersResponse
+	if status := e.do(http.MethodGet, "/api/v1/admin/dead-letters", nil, &resp); status != http.StatusOK {
+		e.t.Fatalf("expected status 200, got %d", status)
+	}
+	return resp
+}
+
+func TestPoisonTaskIsDeadLetteredAndRequeued(t *testing.T) {
+	env := newTestEnv(t)
+	storage := env.useFailingStorage()
+	env.startConsumer()
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+
+	resp := env.waitForStatus(id, domainTranslation.StatusFailed)
+	if resp.Error != "failed to save chunk plan: storage unavailable" {
+		t.Errorf("expected request to fail with the last error, got %q", resp.Error)
+	}
+	if calls := storage.calls.Load(); calls != 3 {
+		t.Errorf("expected task to be attempted 3 times, got %d", calls)
+	}
+
+	deadLetters := env.getDeadLetters()
+	if deadLetters.Count != 1 {
+		t.Fatalf("expected 1 dead-lettered task, got %d", deadLetters.Count)
+	}
+	deadLetter := deadLetters.DeadLetters[0]
+	if deadLetter.Attempts != 3 || deadLetter.Error != resp.Error {
+		t.Errorf("unexpected dead letter: %+v", deadLetter)
+	}
+	if deadLetter.Task == nil || deadLetter.Task.RequestID != id {
+		t.Errorf("expected dead letter to keep task of request %s, got %+v", id, deadLetter.Task)
+	}
+
+	// Listing leaves tasks in the queue
+	if again := env.getDeadLetters(); again.Count != 1 {
+		t.Errorf("expected listing to keep dead-lettered task, got %d", again.Count)
+	}
+
+	storage.broken.Store(false)
+
+	var requeued dto.RequeueDeadLettersResponse
+	body := dto.RequeueDeadLettersRequest{IDs: []string{deadLetter.ID}}
+	if status := env.do(http.MethodPost, "/api/v1/admin/dead-letters/requeue", body, &requeued); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if requeued.Requeued != 1 {
+		t.Errorf("expected 1 requeued task, got %d", requeued.Requeued)
+	}
+
+	resp = env.waitForStatus(id, domainTranslation.StatusCompleted)
+	if resp.Error != "" {
+		t.Errorf("expected error to be cleared, got %q", resp.Error)
+	}
+	if env.getDeadLetters().Count != 0 {
+		t.Error("expected requeued task to leave the dead-letter queue")
+	}
+}
+
+func TestRequeueSelectsTasksAndPurgeEmptiesQueue(t *testing.T) {
+	env := newTestEnv(t)
+	env.useFailingStorage()
+	env.startConsumer()
+
+	first := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	second := env.createRequest(map[string]string{"bye": "Bye"}, "fr")
+	env.waitForStatus(first, domainTranslation.StatusFailed)
+	env.waitForStatus(second, domainTranslation.StatusFailed)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for env.getDeadLetters().Count < 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("tasks were not dead-lettered")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// Unknown IDs requeue nothing
+	var requeued dto.RequeueDeadLettersResponse
+	body := dto.RequeueDeadLettersRequest{IDs: []string{uuid.New().String()}}
+	if status := env.do(http.MethodPost, "/api/v1/admin/dead-letters/requeue", body, &requeued); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if requeued.Requeued != 0 || env.getDeadLetters().Count != 2 {
+		t.Errorf("expected unknown IDs to requeue nothing, got %d", requeued.Requeued)
+	}
+
+	var purged dto.PurgeDeadLettersResponse
+	if status := env.do(http.MethodDelete, "/api/v1/admin/dead-letters", nil, &purged); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if purged.Purged != 2 {
+		t.Errorf("expected 2 purged tasks, got %d", purged.Purged)
+	}
+	if env.getDeadLetters().Count != 0 {
+		t.Error("expected dead-letter queue to be empty after purge")
+	}
+
+	// Purged requests stay failed
+	if resp := env.getRequest(first); resp.Status != string(domainTranslation.StatusFailed) {
+		t.Errorf("expected purged request to stay failed, got %s", resp.Status)
+	}
+}
+
+func TestDeadLetterEndpointsValidation(t *testing.T) {
+	env := newTestEnv(t)
+
+	if status := env.do(http.MethodGet, "/api/v1/admin/dead-letters?limit=0", nil, nil); status != http.StatusBadRequest {
+		t.Errorf("expected status 400 for invalid limit, got %d", status)
+	}
+
+	// Requeue without body requeues everything there is
+	var requeued dto.RequeueDeadLettersResponse
+	if status := env.do(http.MethodPost, "/api/v1/admin/dead-letters/requeue", nil, &requeued); status != http.StatusOK {
+		t.Errorf("expected status 200, got %d", status)
+	}
+	if requeued.Requeued != 0 {
+		t.Errorf("expected nothing to requeue, got %d", requeued.Requeued)
+	}
+}
@@ -0,0 +1,137 @@
+package http_test
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+)
+
+// getChanges gets changes through the API with given query
+func (e *testEnv) getChanges(query url.Values) dto.GetChangesResponse {
+	e.t.Helper()
+
+	var resp dto.GetChangesResponse
+	if status := e.do(http.MethodGet, "/api/v1/translations/changes?"+query.Encode(), nil, &resp); status != http.StatusOK {
+		e.t.Fatalf("expected status 200, got %d", status)
+	}
+
+	return resp
+}
+
+// changeOf finds change of key language in response, nil if absent
+func changeOf(resp dto.GetChangesResponse, key, language string) *dto.ChangeInfo {
+	for i := range resp.Changes {
+		if resp.Changes[i].Key == key && resp.Changes[i].Language == language {
+			return &resp.Changes[i]
+		}
+	}
+	return nil
+}
+
+func TestChangesSinceSequence(t *testing.T) {
+	env := newTestEnv(t)
+
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello", "bye": "Bye"},
+		"es": {"hello": "Hola", "bye": "Adiós"},
+	})
+
+	initial := env.getChanges(url.Values{"since": {"0"}})
+	if initial.Count != 6 || initial.Sequence == 0 {
+		t.Fatalf("expected full initial sync, got %+v", initial)
+	}
+
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello"},
+		"es": {"hello": "Hola Mundo"},
+	})
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello"},
+		"es": {"hello": "¡Hola Mundo!"},
+	})
+	if status := env.do(http.MethodDelete, "/api/v1/translations/bye", nil, nil); status != http.StatusNoContent {
+		t.Fatalf("expected status 204, got %d", status)
+	}
+
+	delta := env.getChanges(url.Values{"since": {strconv.FormatInt(initial.Sequence, 10)}})
+	if delta.FromSequence != initial.Sequence || delta.Sequence <= initial.Sequence {
+		t.Errorf("unexpected sequences: from %d to %d", delta.FromSequence, delta.Sequence)
+	}
+	if delta.Count != 4 {
+		t.Fatalf("expected only net changes since last sync, got %+v", delta.Changes)
+	}
+
+	if hello := changeOf(delta, "hello", "es"); hello == nil || hello.Value != "¡Hola Mundo!" || hello.Deleted {
+		t.Errorf("expected latest Spanish value, got %+v", hello)
+	}
+	for _, language := range []string{domainTranslation.SourceLanguage, "en", "es"} {
+		if bye := changeOf(delta, "bye", language); bye == nil || !bye.Deleted || bye.Value != "" {
+			t.Errorf("expected tombstone for deleted %s value, got %+v", language, bye)
+		}
+	}
+	for i := 1; i < len(delta.Changes); i++ {
+		if delta.Changes[i-1].Sequence >= delta.Changes[i].Sequence {
+			t.Errorf("expected changes in sequence order, got %+v", delta.Changes)
+		}
+	}
+
+	empty := env.getChanges(url.Values{"since": {strconv.FormatInt(delta.Sequence, 10)}})
+	if empty.Count != 0 || empty.Sequence != delta.Sequence {
+		t.Errorf("expected no changes and unchanged sequence, got %+v", empty)
+	}
+}
+
+func TestChangesFilteredByLanguage(t *testing.T) {
+	env := newTestEnv(t)
+
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello"},
+		"es": {"hello": "Hola"},
+		"fr": {"hello": "Bonjour"},
+		"de": {"hello": "Hallo"},
+	})
+
+	resp := env.getChanges(url.Values{"languages": {"es, fr"}})
+	if resp.Count != 2 || changeOf(resp, "hello", "es") == nil || changeOf(resp, "hello", "fr") == nil {
+		t.Errorf("expected only Spanish and French changes, got %+v", resp.Changes)
+	}
+
+	all := env.getChanges(url.Values{})
+	if resp.Sequence != all.Sequence {
+		t.Errorf("expected filtered sync to advance to the same sequence, got %d and %d", resp.Sequence, all.Sequence)
+	}
+}
+
+func TestChangesSinceTime(t *testing.T) {
+	env := newTestEnv(t)
+
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello"},
+		"es": {"hello": "Hola"},
+	})
+	at := checkpoint()
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello", "bye": "Bye"},
+		"es": {"hello": "Hola", "bye": "Adiós"},
+	})
+
+	resp := env.getChanges(url.Values{"since_time": {at.Format(time.RFC3339Nano)}})
+	if resp.Count != 3 || changeOf(resp, "bye", "es") == nil || changeOf(resp, "bye", domainTranslation.SourceLanguage) == nil {
+		t.Errorf("expected only changes after timestamp, got %+v", resp.Changes)
+	}
+}
+
+func TestChangesRejectsInvalidParameters(t *testing.T) {
+	env := newTestEnv(t)
+
+	for _, query := range []string{"since=abc", "since=-1", "since_time=yesterday"} {
+		if status := env.do(http.MethodGet, "/api/v1/translations/changes?"+query, nil, nil); status != http.StatusBadRequest {
+			t.Errorf("expected status 400 for %s, got %d", query, status)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package dto
+
+// GetQueueInfoResponse represents response to task queue info request
+type GetQueueInfoResponse struct {
+	Queued int `json:"queued" example:"12"`
+}
+
+// DeadLetterTaskInfo represents dead-lettered translation task, chunk fields are only set for chunk tasks
+type DeadLetterTaskInfo struct {
+	RequestID string   `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Languages []string `json:"languages" example:"es"`
+	PlanID    *string  `json:"plan_id,omitempty" example:"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`
+	Chunk     int      `json:"chunk,omitempty" example:"2"`
+	Keys      []string `json:"keys,omitempty" example:"hello,welcome"`
+}
+
+// DeadLetterInfo represents task that failed all attempts, task is omitted when it could not be decoded
+type DeadLetterInfo struct {
+	ID             string              `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Task           *DeadLetterTaskInfo `json:"task,omitempty"`
+	Attempts       int                 `json:"attempts" example:"5"`
+	Error          string              `json:"error" example:"failed to save chunk plan: connection refused"`
+	DeadLetteredAt string              `json:"dead_lettered_at" example:"2024-01-01T12:05:00Z"`
+}
+
+// GetDeadLettersResponse represents response to list dead-lettered tasks request
+type GetDeadLettersResponse struct {
+	DeadLetters []DeadLetterInfo `json:"dead_letters"`
+	Count       int              `json:"count" example:"1"`
+}
+
+// RequeueDeadLettersRequest represents request to process dead-lettered tasks again
+type RequeueDeadLettersRequest struct {
+	IDs []string `json:"ids,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// RequeueDeadLettersResponse represents response to requeue dead-lettered tasks request
+type RequeueDeadLettersResponse struct {
+	Requeued int `json:"requeued" example:"1"`
+}
+
+// PurgeDeadLettersResponse represents response to purge dead-lettered tasks request
+type PurgeDeadLettersResponse struct {
+	Purged int `json:"purged" example:"3"`
+}
+
+// AuditEntryInfo represents record of one mutating API request
+type AuditEntryInfo struct {
+	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// ID of API key or user that made the request
+	Actor     string `json:"actor" example:"key_3f2a9c1b7d4e"`
+	ActorName string `json:"actor_name,omitempty" example:"mobile-app-ci"`
+	Action    string `json:"action,omitempty" example:"translations:delete"`
+	Method    string `json:"method" example:"DELETE"`
+	Path      string `json:"path" example:"/api/v1/translations/welcome"`
+	Target    string `json:"target,omitempty" example:"welcome"`
+	Project   string `json:"project,omitempty" example:"mobile-app"`
+	// Summaries of the target before and after the change
+	Before    map[string]any `json:"before,omitempty"`
+	After     map[string]any `json:"after,omitempty"`
+	IP        string         `json:"ip" example:"203.0.113.7"`
+	Status    int            `json:"status" example:"204"`
+	CreatedAt string         `json:"created_at" example:"2024-01-01T12:00:00Z"`
+}
+
+// ListAuditEntriesResponse represents one page of listed audit entries
+type ListAuditEntriesResponse struct {
+	Entries []AuditEntryInfo `json:"entries"`
+	Count   int              `json:"count" example:"100"`
+	// Number of entries matching filters
+	Total  int `json:"total" example:"1234"`
+	Offset int `json:"offset" example:"0"`
+	Limit  int `json:"limit" example:"100"`
+}
@@ -0,0 +1,40 @@
+package dto
+
+// CreateAPIKeyRequest represents request to create API key
+type CreateAPIKeyRequest struct {
+	Name string `json:"name" validate:"required" example:"mobile-app-ci"`
+	// Projects the key is limited to, all projects when omitted
+	Projects    []string `json:"projects,omitempty" example:"mobile-app"`
+	Permissions []string `json:"permissions" validate:"required" example:"translate,read"`
+	// RFC 3339 expiry time, the key doesn't expire when omitted
+	ExpiresAt string `json:"expires_at,omitempty" example:"2025-01-01T00:00:00Z"`
+}
+
+// RotateAPIKeyRequest represents request to rotate API key
+type RotateAPIKeyRequest struct {
+	// Seconds the old key stays valid after rotation, 24 hours when omitted
+	OverlapSeconds *int `json:"overlap_seconds,omitempty" example:"3600"`
+}
+
+// APIKeyInfo represents API key, its secret is only returned on creation and rotation
+type APIKeyInfo struct {
+	ID          string   `json:"id" example:"key_3f2a9c1b7d4e"`
+	Name        string   `json:"name" example:"mobile-app-ci"`
+	Key         string   `json:"key,omitempty" example:"tk_3f2a9c1b7d4e_9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f0"`
+	Prefix      string   `json:"prefix" example:"tk_3f2a9c1b7d4e"`
+	Projects    []string `json:"projects,omitempty" example:"mobile-app"`
+	Permissions []string `json:"permissions" example:"translate,read"`
+	Active      bool     `json:"active" example:"true"`
+	CreatedAt   string   `json:"created_at" example:"2024-01-01T12:00:00Z"`
+	ExpiresAt   *string  `json:"expires_at,omitempty" example:"2025-01-01T00:00:00Z"`
+	RevokedAt   *string  `json:"revoked_at,omitempty" example:"2024-06-01T12:00:00Z"`
+	LastUsedAt  *string  `json:"last_used_at,omitempty" example:"2024-01-02T08:30:00Z"`
+	RotatedFrom string   `json:"rotated_from,omitempty" example:"key_8b1e0d2c4a6f"`
+	ReplacedBy  string   `json:"replaced_by,omitempty" example:"key_5d7c9e1f3a2b"`
+}
+
+// GetAPIKeysResponse represents response to list API keys request
+type GetAPIKeysResponse struct {
+	APIKeys []APIKeyInfo `json:"api_keys"`
+	Count   int          `json:"count" example:"2"`
+}
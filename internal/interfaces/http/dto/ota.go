@@ -0,0 +1,16 @@
+package dto
+
+// OTAManifestResponse represents manifest of locales available for over-the-air delivery
+type OTAManifestResponse struct {
+	Release     string          `json:"release" example:"v2.3.0"`
+	PublishedAt string          `json:"published_at" example:"2024-01-01T12:00:00Z"`
+	Locales     []OTALocaleInfo `json:"locales"`
+}
+
+// OTALocaleInfo represents locale bundle listed in manifest
+type OTALocaleInfo struct {
+	Locale   string `json:"locale" example:"es"`
+	Hash     string `json:"hash" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	KeyCount int    `json:"key_count" example:"120"`
+	URL      string `json:"url" example:"/api/v1/ota/releases/v2.3.0/locales/es"`
+}
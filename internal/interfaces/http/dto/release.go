@@ -0,0 +1,50 @@
+package dto
+
+// CreateReleaseRequest represents request to freeze current translations into a release
+type CreateReleaseRequest struct {
+	Name        string `json:"name" validate:"required" example:"v2.3.0"`
+	Description string `json:"description,omitempty" example:"Spring feature release"`
+}
+
+// ReleaseInfo represents release summary
+type ReleaseInfo struct {
+	Name        string   `json:"name" example:"v2.3.0"`
+	Description string   `json:"description,omitempty" example:"Spring feature release"`
+	Languages   []string `json:"languages" example:"es,fr,de"`
+	KeyCount    int      `json:"key_count" example:"120"`
+	CreatedAt   string   `json:"created_at" example:"2024-01-01T12:00:00Z"`
+	PublishedAt *string  `json:"published_at,omitempty" example:"2024-01-01T12:05:00Z"`
+}
+
+// GetReleasesResponse represents response to list releases request
+type GetReleasesResponse struct {
+	Releases []ReleaseInfo `json:"releases"`
+	Count    int           `json:"count" example:"2"`
+}
+
+// GetReleaseResponse represents release bundle
+type GetReleaseResponse struct {
+	ReleaseInfo
+	SourceData   map[string]string            `json:"source_data"`
+	Translations map[string]map[string]string `json:"translations"`
+}
+
+// ValueChangeInfo represents changed value of a key
+type ValueChangeInfo struct {
+	From string `json:"from" example:"Hola"`
+	To   string `json:"to" example:"Hola Mundo"`
+}
+
+// LanguageDiffInfo represents differences of one language between two releases
+type LanguageDiffInfo struct {
+	Added   map[string]string          `json:"added"`
+	Removed map[string]string          `json:"removed"`
+	Changed map[string]ValueChangeInfo `json:"changed"`
+}
+
+// ReleaseDiffResponse represents differences between two releases
+type ReleaseDiffResponse struct {
+	From      string                      `json:"from" example:"v2.2.0"`
+	To        string                      `json:"to" example:"v2.3.0"`
+	Languages map[string]LanguageDiffInfo `json:"languages"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+// TranslateRequest represents request to translate a few keys synchronously
+type TranslateRequest struct {
+	SourceData map[string]string `json:"source_data" validate:"required" example:{"save":"Save","cancel":"Cancel"}`
+	Languages  []string          `json:"languages" example:"es,fr" validate:"required,min=1"`
+	Project    string            `json:"project,omitempty" example:"admin-panel"`
+}
+
+// TranslateResponse represents translations of synchronous translation request
+type TranslateResponse struct {
+	RequestID    string                       `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status       string                       `json:"status" example:"completed"`
+	Translations map[string]map[string]string `json:"translations" example:{"es":{"save":"Guardar","cancel":"Cancelar"},"fr":{"save":"Enregistrer","cancel":"Annuler"}}`
+	Progress     *RequestProgressInfo         `json:"progress,omitempty"`
+	// Keys that could not be translated
+	Failed []KeyResultInfo `json:"failed,omitempty"`
+}
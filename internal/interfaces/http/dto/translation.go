@@ -2,8 +2,14 @@ package dto
 
 // CreateTranslationRequestRequest represents translation creation request
 type CreateTranslationRequestRequest struct {
-	SourceData map[string]string `json:"source_data" validate:"required" example:{"hello":"Hello World","welcome":"Welcome to our app","goodbye":"Goodbye"}`
-	Languages  []string          `json:"languages" example:"es,fr,de" validate:"required,min=1"`
+	SourceData  map[string]string `json:"source_data" validate:"required" example:{"hello":"Hello World","welcome":"Welcome to our app","goodbye":"Goodbye"}`
+	Languages   []string          `json:"languages" example:"es,fr,de" validate:"required,min=1"`
+	Project     string            `json:"project,omitempty" example:"mobile-app"`
+	CallbackURL string            `json:"callback_url,omitempty" example:"https://example.com/hooks/translation"`
+	// Priority from 0 (lowest) to 9 (highest), 5 when omitted
+	Priority *int `json:"priority,omitempty" example:"8" minimum:"0" maximum:"9"`
+	// Return identical request of the project that is still in progress instead of creating a new one
+	Coalesce bool `json:"coalesce,omitempty" example:"true"`
 }
 
 // CreateTranslationRequestResponse represents response to creation request
@@ -11,6 +17,7 @@ type CreateTranslationRequestResponse struct {
 	RequestID string `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
 	Status    string `json:"status" example:"pending"`
 	Message   string `json:"message" example:"Translation request created successfully and queued for processing"`
+	Coalesced bool   `json:"coalesced,omitempty" example:"false"`
 }
 
 // GetTranslationRequestResponse represents response to get request
@@ -23,6 +30,39 @@ type GetTranslationRequestResponse struct {
 	CreatedAt      string                       `json:"created_at" example:"2024-01-01T12:00:00Z"`
 	UpdatedAt      string                       `json:"updated_at" example:"2024-01-01T12:05:00Z"`
 	CompletedAt    *string                      `json:"completed_at,omitempty" example:"2024-01-01T12:05:00Z"`
+	Progress       *RequestProgressInfo         `json:"progress,omitempty"`
+	Results        []KeyResultInfo              `json:"results,omitempty"`
+	Project        string                       `json:"project,omitempty" example:"mobile-app"`
+	CallbackURL    string                       `json:"callback_url,omitempty" example:"https://example.com/hooks/translation"`
+	Priority       int                          `json:"priority" example:"5"`
+	Error          string                       `json:"error,omitempty" example:"failed to save chunk plan: connection refused"`
+	CreatedBy      string                       `json:"created_by,omitempty" example:"key_3f2a9c1b7d4e"`
+}
+
+// LanguageProgressInfo represents number of request keys per outcome, skipped keys were reused from cache,
+// discarded translations were removed when request was cancelled
+type LanguageProgressInfo struct {
+	Total     int `json:"total" example:"3000"`
+	Done      int `json:"done" example:"1200"`
+	Failed    int `json:"failed" example:"3"`
+	Skipped   int `json:"skipped" example:"800"`
+	Discarded int `json:"discarded" example:"0"`
+	Pending   int `json:"pending" example:"997"`
+}
+
+// RequestProgressInfo represents request progress overall and per language
+type RequestProgressInfo struct {
+	LanguageProgressInfo
+	Languages map[string]LanguageProgressInfo `json:"languages"`
+}
+
+// KeyResultInfo represents outcome of translating one key to one language
+type KeyResultInfo struct {
+	Key       string `json:"key" example:"hello"`
+	Language  string `json:"language" example:"es"`
+	Outcome   string `json:"outcome" example:"failed"`
+	Error     string `json:"error,omitempty" example:"rate limit exceeded"`
+	UpdatedAt string `json:"updated_at" example:"2024-01-01T12:05:00Z"`
 }
 
 // ErrorResponse represents error response
@@ -58,11 +98,62 @@ type CacheTranslationsErrorResponse struct {
 	TotalKeys    int      `json:"total_keys" example:"4"`
 }
 
+// CancelTranslationRequestRequest represents options of cancelling request
+type CancelTranslationRequestRequest struct {
+	// Remove translations the request already saved instead of keeping them
+	DiscardTranslations bool `json:"discard_translations" example:"false"`
+}
+
 // CancelTranslationRequestResponse represents response to cancel request
 type CancelTranslationRequestResponse struct {
 	RequestID string `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
 	Status    string `json:"status" example:"cancelled"`
 	Message   string `json:"message" example:"Translation request cancelled successfully"`
+	Discarded int    `json:"discarded" example:"0"`
+}
+
+// RetryTranslationRequestRequest represents key languages translated again although they have translations,
+// keys in languages, all keys or all languages of request when one of them is omitted
+type RetryTranslationRequestRequest struct {
+	Keys      []string `json:"keys,omitempty" example:"welcome"`
+	Languages []string `json:"languages,omitempty" example:"de"`
+}
+
+// RetryTranslationRequestResponse represents response to retry request
+type RetryTranslationRequestResponse struct {
+	RequestID string `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status    string `json:"status" example:"pending"`
+	// Number of key languages that will be translated
+	Retried int    `json:"retried" example:"3"`
+	Message string `json:"message" example:"Translation request queued for retry"`
+}
+
+// ListTranslationRequestsResponse represents one page of listed translation requests
+type ListTranslationRequestsResponse struct {
+	Requests []TranslationRequestSummary `json:"requests"`
+	Count    int                         `json:"count" example:"50"`
+	// Number of requests matching filters
+	Total  int `json:"total" example:"1234"`
+	Offset int `json:"offset" example:"0"`
+	Limit  int `json:"limit" example:"50"`
+}
+
+// TranslationRequestSummary represents listed translation request, archived requests only keep their summary
+type TranslationRequestSummary struct {
+	RequestID   string                `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status      string                `json:"status" example:"completed"`
+	Project     string                `json:"project,omitempty" example:"mobile-app"`
+	CreatedBy   string                `json:"created_by,omitempty" example:"key_3f2a9c1b7d4e"`
+	Languages   []string              `json:"languages" example:"es,fr,de"`
+	Keys        int                   `json:"keys" example:"120"`
+	Priority    int                   `json:"priority" example:"5"`
+	Error       string                `json:"error,omitempty" example:"failed to save chunk plan: connection refused"`
+	Progress    *LanguageProgressInfo `json:"progress,omitempty"`
+	CreatedAt   string                `json:"created_at" example:"2024-01-01T12:00:00Z"`
+	UpdatedAt   string                `json:"updated_at" example:"2024-01-01T12:05:00Z"`
+	CompletedAt *string               `json:"completed_at,omitempty" example:"2024-01-01T12:05:00Z"`
+	Archived    bool                  `json:"archived" example:"false"`
+	ArchivedAt  *string               `json:"archived_at,omitempty" example:"2024-01-02T12:05:00Z"`
 }
 
 // GetIncompleteRequestsResponse represents response to get incomplete requests
@@ -80,3 +171,71 @@ type IncompleteRequestInfo struct {
 	CreatedAt  string            `json:"created_at" example:"2024-01-01T12:00:00Z"`
 	UpdatedAt  string            `json:"updated_at" example:"2024-01-01T12:05:00Z"`
 }
+
+// HistoryEntryInfo represents one versioned change of translation key
+type HistoryEntryInfo struct {
+	Key           string `json:"key" example:"hello"`
+	Language      string `json:"language" example:"es"`
+	Version       int64  `json:"version" example:"3"`
+	Action        string `json:"action" example:"updated"`
+	Value         string `json:"value,omitempty" example:"Hola Mundo"`
+	PreviousValue string `json:"previous_value,omitempty" example:"Hola"`
+	Provider      string `json:"provider,omitempty" example:"openai"`
+	Model         string `json:"model,omitempty" example:"gpt-4-0613"`
+	RequestID     string `json:"request_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	User          string `json:"user,omitempty" example:"jane@example.com"`
+	CreatedAt     string `json:"created_at" example:"2024-01-01T12:05:00.123456789Z"`
+}
+
+// GetKeyHistoryResponse represents response to get key history request
+type GetKeyHistoryResponse struct {
+	Key     string             `json:"key" example:"hello"`
+	History []HistoryEntryInfo `json:"history"`
+	Count   int                `json:"count" example:"3"`
+}
+
+// RollbackTranslationsRequest represents request to roll translations back to a point in time
+type RollbackTranslationsRequest struct {
+	Key       string `json:"key,omitempty" example:"hello"`
+	Language  string `json:"language,omitempty" example:"es"`
+	Timestamp string `json:"timestamp" validate:"required" example:"2024-01-01T12:00:00Z"`
+}
+
+// RollbackTranslationsResponse represents response to rollback request
+type RollbackTranslationsResponse struct {
+	Message string             `json:"message" example:"Translations rolled back successfully"`
+	Count   int                `json:"count" example:"2"`
+	Changes []HistoryEntryInfo `json:"changes"`
+}
+
+// ChangeInfo represents the latest state of one key language changed since requested sequence
+type ChangeInfo struct {
+	Key      string `json:"key" example:"hello"`
+	Language string `json:"language" example:"es"`
+	Value    string `json:"value,omitempty" example:"Hola Mundo"`
+	Deleted  bool   `json:"deleted" example:"false"`
+	Sequence int64  `json:"sequence" example:"42"`
+}
+
+// GetChangesResponse represents response to get changes request
+type GetChangesResponse struct {
+	FromSequence int64        `json:"from_sequence" example:"40"`
+	Sequence     int64        `json:"sequence" example:"42"`
+	Changes      []ChangeInfo `json:"changes"`
+	Count        int          `json:"count" example:"2"`
+}
+
+// RequestEventInfo represents progress event of translation request.
+// Status events carry status and, for the first event of a stream, progress; key events carry key outcome.
+type RequestEventInfo struct {
+	Type      string               `json:"type" example:"key"`
+	RequestID string               `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status    string               `json:"status,omitempty" example:"processing"`
+	Progress  *RequestProgressInfo `json:"progress,omitempty"`
+	Key       string               `json:"key,omitempty" example:"hello"`
+	Language  string               `json:"language,omitempty" example:"es"`
+	Outcome   string               `json:"outcome,omitempty" example:"translated"`
+	Error     string               `json:"error,omitempty" example:"rate limit exceeded"`
+	Value     string               `json:"value,omitempty" example:"Hola Mundo"`
+	CreatedAt string               `json:"created_at" example:"2024-01-01T12:05:00.123456789Z"`
+}
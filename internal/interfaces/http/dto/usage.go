@@ -0,0 +1,17 @@
+package dto
+
+// UsageInfo represents usage of a rate limit or quota in its current window
+type UsageInfo struct {
+	Name string `json:"name" example:"keys_per_day"`
+	// Limit and Remaining are omitted for unlimited quotas
+	Limit     *int64 `json:"limit,omitempty" example:"10000"`
+	Used      int64  `json:"used" example:"2350"`
+	Remaining *int64 `json:"remaining,omitempty" example:"7650"`
+	ResetAt   string `json:"reset_at" example:"2024-01-02T00:00:00Z"`
+}
+
+// GetUsageResponse represents usage of rate limit of the caller and quotas of a project
+type GetUsageResponse struct {
+	Project string      `json:"project" example:"mobile-app"`
+	Usage   []UsageInfo `json:"usage"`
+}
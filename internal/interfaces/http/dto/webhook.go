@@ -0,0 +1,56 @@
+package dto
+
+import "encoding/json"
+
+// CreateWebhookRequest represents request to register a project webhook
+type CreateWebhookRequest struct {
+	Project string   `json:"project" validate:"required" example:"mobile-app"`
+	URL     string   `json:"url" validate:"required" example:"https://example.com/hooks/translation"`
+	Secret  string   `json:"secret,omitempty" example:"s3cr3t"`
+	Events  []string `json:"events,omitempty" example:"request.completed,request.failed"`
+}
+
+// WebhookInfo represents registered webhook, secret is only returned on creation
+type WebhookInfo struct {
+	ID        string   `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Project   string   `json:"project" example:"mobile-app"`
+	URL       string   `json:"url" example:"https://example.com/hooks/translation"`
+	Secret    string   `json:"secret,omitempty" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	Events    []string `json:"events" example:"request.completed,request.failed"`
+	CreatedAt string   `json:"created_at" example:"2024-01-01T12:00:00Z"`
+}
+
+// GetWebhooksResponse represents response to list webhooks request
+type GetWebhooksResponse struct {
+	Webhooks []WebhookInfo `json:"webhooks"`
+	Count    int           `json:"count" example:"1"`
+}
+
+// DeliveryAttemptInfo represents one attempt to deliver webhook payload
+type DeliveryAttemptInfo struct {
+	StatusCode int    `json:"status_code,omitempty" example:"500"`
+	Error      string `json:"error,omitempty" example:"unexpected status code 500"`
+	DurationMs int64  `json:"duration_ms" example:"120"`
+	CreatedAt  string `json:"created_at" example:"2024-01-01T12:05:00Z"`
+}
+
+// DeliveryInfo represents webhook delivery with its attempt log
+type DeliveryInfo struct {
+	ID            string                `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	WebhookID     *string               `json:"webhook_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	RequestID     string                `json:"request_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	URL           string                `json:"url" example:"https://example.com/hooks/translation"`
+	Event         string                `json:"event" example:"request.completed"`
+	Payload       json.RawMessage       `json:"payload" swaggertype:"object"`
+	Status        string                `json:"status" example:"succeeded"`
+	Attempts      []DeliveryAttemptInfo `json:"attempts"`
+	NextAttemptAt *string               `json:"next_attempt_at,omitempty" example:"2024-01-01T12:05:10Z"`
+	CreatedAt     string                `json:"created_at" example:"2024-01-01T12:05:00Z"`
+	UpdatedAt     string                `json:"updated_at" example:"2024-01-01T12:05:00Z"`
+}
+
+// GetDeliveriesResponse represents response to list webhook deliveries request
+type GetDeliveriesResponse struct {
+	Deliveries []DeliveryInfo `json:"deliveries"`
+	Count      int            `json:"count" example:"2"`
+}
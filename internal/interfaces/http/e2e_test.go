@@ -0,0 +1,442 @@
+package http_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	appTranslation "translation/internal/application/translation"
+	"translation/internal/config"
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/infrastructure/memory"
+	"translation/internal/infrastructure/webhook"
+	httpInterface "translation/internal/interfaces/http"
+	"translation/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const testAPIKey = "test-api-key"
+
+// testEnv wires the HTTP API to in-memory infrastructure
+type testEnv struct {
+	t          *testing.T
+	repo       *memory.Repository
+	storage    domainTranslation.Repository
+	queue      *memory.Queue
+	translator *memory.Translator
+	events     *memory.EventBus
+	locker     *memory.Locker
+	usage      *memory.UsageCounter
+	appService *appTranslation.Service
+	app        *fiber.App
+	otaConfig  config.OTAConfig
+	webhooks   config.WebhookConfig
+	workers    config.WorkerConfig
+	syncConfig config.SyncConfig
+	retention  config.RetentionConfig
+	rateLimits config.RateLimitConfig
+	users      httpInterface.UserAuthenticator
+}
+
+// newTestEnv creates a new test environment with empty storage
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+
+	repo := memory.NewRepository()
+	env := &testEnv{
+		t:          t,
+		repo:       repo,
+		storage:    repo,
+		translator: memory.NewTranslator(),
+		events:     memory.NewEventBus(),
+		locker:     memory.NewLocker(),
+		usage:      memory.NewUsageCounter(),
+		otaConfig:  config.OTAConfig{Public: true, CacheMaxAge: 60},
+		webhooks: config.WebhookConfig{
+			Secret:         "test-webhook-secret",
+			MaxAttempts:    3,
+			RetryBaseDelay: 10 * time.Millisecond,
+			Timeout:        time.Second,
+			PollInterval:   10 * time.Millisecond,
+			// Test receivers listen on loopback
+			AllowedNetworks: []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")},
+		},
+		workers:    config.WorkerConfig{Concurrency: 1, ChunkSize: 50},
+		syncConfig: config.SyncConfig{MaxTranslations: 10, Timeout: 5 * time.Second},
+		retention:  config.RetentionConfig{ArchiveAfter: 24 * time.Hour},
+	}
+	env.restart()
+
+	return env
+}
+
+// restart simulates process restart: storage and locks survive, queued tasks are lost.
+// Services use storage, which tests may replace with a wrapper of repo.
+func (e *testEnv) restart() {
+	e.queue = memory.NewQueue(100, domainTranslation.RetryPolicy{MaxAttempts: 3, Delay: 10 * time.Millisecond})
+	e.appService = appTranslation.NewService(
+		domainTranslation.NewService(e.storage), e.translator, e.queue, e.events,
+		webhook.NewSender(e.webhooks.Timeout, e.webhooks.AllowedNetworks), e.locker, e.usage, e.webhooks, e.workers, e.retention, e.rateLimits,
+	)
+
+	e.app = fiber.New()
+	httpInterface.SetupRoutes(
+		e.app,
+		httpInterface.NewHandler(e.appService, e.syncConfig),
+		httpInterface.NewOTAHandler(e.appService, e.otaConfig),
+		e.users,
+		testAPIKey,
+	)
+}
+
+// startConsumer starts processing queued tasks until the test ends
+func (e *testEnv) startConsumer() {
+	e.t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := e.runConsumer(ctx)
+	e.t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+// runConsumer processes queued tasks until ctx is done, returned channel is closed once consumer stopped
+func (e *testEnv) runConsumer(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := e.appService.StartConsumer(ctx); err != nil {
+			e.t.Errorf("failed to start consumer: %v", err)
+		}
+	}()
+	return done
+}
+
+// do performs authorized request against the API and decodes JSON response into out
+func (e *testEnv) do(method, path string, body any, out any) int {
+	e.t.Helper()
+
+	return e.doAs(testAPIKey, method, path, body, out)
+}
+
+// doAs performs request authorized with token against the API and decodes JSON response into out
+func (e *testEnv) doAs(token, method, path string, body any, out any) int {
+	e.t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			e.t.Fatalf("failed to marshal body: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return e.send(req, out)
+}
+
+// send sends raw request to the API and decodes JSON response into out
+func (e *testEnv) send(req *http.Request, out any) int {
+	e.t.Helper()
+
+	resp, err := e.app.Test(req, -1)
+	if err != nil {
+		e.t.Fatalf("request %s %s failed: %v", req.Method, req.URL.Path, err)
+	}
+	defer resp.Body.Close()
+
+	if out != nil {
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			e.t.Fatalf("failed to read response: %v", err)
+		}
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, out); err != nil {
+				e.t.Fatalf("failed to decode response %q: %v", data, err)
+			}
+		}
+	}
+
+	return resp.StatusCode
+}
+
+// createRequest creates translation request and returns its ID
+func (e *testEnv) createRequest(sourceData map[string]string, languages ...string) string {
+	e.t.Helper()
+
+	var resp dto.CreateTranslationRequestResponse
+	status := e.do(http.MethodPost, "/api/v1/translations", dto.CreateTranslationRequestRequest{
+		SourceData: sourceData,
+		Languages:  languages,
+	}, &resp)
+	if status != http.StatusCreated {
+		e.t.Fatalf("expected status 201, got %d", status)
+	}
+
+	return resp.RequestID
+}
+
+// getRequest fetches translation request by ID
+func (e *testEnv) getRequest(id string) dto.GetTranslationRequestResponse {
+	e.t.Helper()
+
+	var resp dto.GetTranslationRequestResponse
+	if status := e.do(http.MethodGet, "/api/v1/translations/"+id, nil, &resp); status != http.StatusOK {
+		e.t.Fatalf("expected status 200, got %d", status)
+	}
+
+	return resp
+}
+
+// waitForStatus polls request until it reaches status
+func (e *testEnv) waitForStatus(id string, status domainTranslation.RequestStatus) dto.GetTranslationRequestResponse {
+	e.t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		resp := e.getRequest(id)
+		if resp.Status == string(status) {
+			return resp
+		}
+		if time.Now().After(deadline) {
+			e.t.Fatalf("request %s stuck in status %s, expected %s", id, resp.Status, status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestCreateProcessAndFetch(t *testing.T) {
+	env := newTestEnv(t)
+	env.startConsumer()
+
+	id := env.createRequest(map[string]string{
+		"hello":       "Hello World",
+		"welcome":     "Welcome",
+		"@@locale":    "en",
+		"@helloLabel": "metadata",
+	}, "es", "fr")
+
+	resp := env.waitForStatus(id, domainTranslation.StatusCompleted)
+
+	for _, lang := range []string{"es", "fr"} {
+		for key, value := range map[string]string{"hello": "Hello World", "welcome": "Welcome"} {
+			if got, want := resp.TranslatedData[lang][key], memory.FakeTranslation(value, lang); got != want {
+				t.Errorf("translation %s/%s = %q, want %q", lang, key, got, want)
+			}
+		}
+		if _, exists := resp.TranslatedData[lang]["@@locale"]; exists {
+			t.Errorf("metadata key must not be translated to %s", lang)
+		}
+	}
+
+	if calls := env.translator.Calls(); calls != 4 {
+		t.Errorf("expected 4 translator calls, got %d", calls)
+	}
+}
+
+func TestExistingTranslationsAreReused(t *testing.T) {
+	env := newTestEnv(t)
+	env.startConsumer()
+
+	first := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	env.waitForStatus(first, domainTranslation.StatusCompleted)
+
+	second := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	resp := env.waitForStatus(second, domainTranslation.StatusCompleted)
+
+	if got, want := resp.TranslatedData["es"]["hello"], memory.FakeTranslation("Hello", "es"); got != want {
+		t.Errorf("translation = %q, want %q", got, want)
+	}
+	if calls := env.translator.Calls(); calls != 1 {
+		t.Errorf("expected cached translation to be reused, got %d translator calls", calls)
+	}
+}
+
+func TestFailedTranslationsAreSkipped(t *testing.T) {
+	env := newTestEnv(t)
+	env.translator.FailLanguage("de", errors.New("provider unavailable"))
+	env.startConsumer()
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es", "de")
+	resp := env.waitForStatus(id, domainTranslation.StatusPartiallyCompleted)
+
+	if _, exists := resp.TranslatedData["de"]["hello"]; exists {
+		t.Errorf("expected no German translation, got %q", resp.TranslatedData["de"]["hello"])
+	}
+	if got, want := resp.TranslatedData["es"]["hello"], memory.FakeTranslation("Hello", "es"); got != want {
+		t.Errorf("translation = %q, want %q", got, want)
+	}
+}
+
+func TestCreateValidation(t *testing.T) {
+	env := newTestEnv(t)
+
+	tests := []struct {
+		name string
+		body any
+	}{
+		{"missing source data", dto.CreateTranslationRequestRequest{Languages: []string{"es"}}},
+		{"missing languages", dto.CreateTranslationRequestRequest{SourceData: map[string]string{"hello": "Hello"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp dto.ErrorResponse
+			if status := env.do(http.MethodPost, "/api/v1/translations", tt.body, &resp); status != http.StatusBadRequest {
+				t.Errorf("expected status 400, got %d", status)
+			}
+			if resp.Error == "" {
+				t.Error("expected error message")
+			}
+		})
+	}
+}
+
+func TestAuthorizationIsRequired(t *testing.T) {
+	env := newTestEnv(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/translations/incomplete", nil)
+	if status := env.send(req, nil); status != http.StatusUnauthorized {
+		t.Errorf("expected status 401 without API key, got %d", status)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/translations/incomplete", nil)
+	req.Header.Set("Authorization", "Bearer wrong-key")
+	if status := env.send(req, nil); status != http.StatusUnauthorized {
+		t.Errorf("expected status 401 with wrong API key, got %d", status)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/health", nil)
+	if status := env.send(req, nil); status != http.StatusOK {
+		t.Errorf("expected public health check, got %d", status)
+	}
+}
+
+func TestCancelPendingRequest(t *testing.T) {
+	env := newTestEnv(t)
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+
+	var cancelResp dto.CancelTranslationRequestResponse
+	if status := env.do(http.MethodPost, "/api/v1/translations/"+id+"/cancel", nil, &cancelResp); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if cancelResp.Status != string(domainTranslation.StatusCancelled) {
+		t.Errorf("expected cancelled status, got %s", cancelResp.Status)
+	}
+
+	if status := env.do(http.MethodPost, "/api/v1/translations/"+id+"/cancel", nil, nil); status != http.StatusConflict {
+		t.Errorf("expected status 409 on repeated cancel, got %d", status)
+	}
+
+	// Tasks are consumed in order, so once the follow-up request completes
+	// the cancelled task has been picked up and skipped
+	env.startConsumer()
+	next := env.createRequest(map[string]string{"bye": "Bye"}, "es")
+	env.waitForStatus(next, domainTranslation.StatusCompleted)
+
+	if resp := env.getRequest(id); resp.Status != string(domainTranslation.StatusCancelled) {
+		t.Errorf("expected request to stay cancelled, got %s", resp.Status)
+	}
+	if calls := env.translator.Calls(); calls != 1 {
+		t.Errorf("expected only follow-up request to be translated, got %d translator calls", calls)
+	}
+}
+
+func TestCacheImport(t *testing.T) {
+	env := newTestEnv(t)
+
+	var cacheResp dto.CacheTranslationsResponse
+	status := env.do(http.MethodPost, "/api/v1/translations/cache", dto.CacheTranslationsRequest{
+		Translations: map[string]map[string]string{
+			"en": {"hello": "Hello"},
+			"es": {"hello": "Hola"},
+		},
+	}, &cacheResp)
+	if status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if cacheResp.Count != 1 {
+		t.Errorf("expected 1 cached key, got %d", cacheResp.Count)
+	}
+
+	var partialResp dto.CacheTranslationsErrorResponse
+	status = env.do(http.MethodPost, "/api/v1/translations/cache", dto.CacheTranslationsRequest{
+		Translations: map[string]map[string]string{
+			"en": {"bye": "Bye"},
+			"es": {"bye": "Adiós", "orphan": "Huérfano"},
+		},
+	}, &partialResp)
+	if status != http.StatusMultiStatus {
+		t.Fatalf("expected status 207, got %d", status)
+	}
+	if len(partialResp.SkippedKeys) != 1 || partialResp.SkippedKeys[0] != "orphan" {
+		t.Errorf("expected orphan key to be skipped, got %v", partialResp.SkippedKeys)
+	}
+
+	env.startConsumer()
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	resp := env.waitForStatus(id, domainTranslation.StatusCompleted)
+
+	if got := resp.TranslatedData["es"]["hello"]; got != "Hola" {
+		t.Errorf("expected cached translation, got %q", got)
+	}
+	if calls := env.translator.Calls(); calls != 0 {
+		t.Errorf("expected no translator calls, got %d", calls)
+	}
+}
+
+func TestDeleteTranslationKey(t *testing.T) {
+	env := newTestEnv(t)
+
+	env.do(http.MethodPost, "/api/v1/translations/cache", dto.CacheTranslationsRequest{
+		Translations: map[string]map[string]string{"en": {"hello": "Hello"}},
+	}, nil)
+
+	if status := env.do(http.MethodDelete, "/api/v1/translations/hello", nil, nil); status != http.StatusNoContent {
+		t.Fatalf("expected status 204, got %d", status)
+	}
+	if status := env.do(http.MethodDelete, "/api/v1/translations/hello", nil, nil); status != http.StatusNotFound {
+		t.Errorf("expected status 404 for deleted key, got %d", status)
+	}
+}
+
+func TestRecoverIncompleteRequests(t *testing.T) {
+	env := newTestEnv(t)
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+
+	// Queued task is lost on restart, only the stored request survives
+	env.restart()
+
+	var incomplete dto.GetIncompleteRequestsResponse
+	if status := env.do(http.MethodGet, "/api/v1/translations/incomplete", nil, &incomplete); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if incomplete.Count != 1 || incomplete.Requests[0].RequestID != id {
+		t.Fatalf("expected request %s to be incomplete, got %+v", id, incomplete.Requests)
+	}
+
+	if err := env.appService.RecoverIncompleteRequests(context.Background()); err != nil {
+		t.Fatalf("failed to recover requests: %v", err)
+	}
+	env.startConsumer()
+
+	resp := env.waitForStatus(id, domainTranslation.StatusCompleted)
+	if got, want := resp.TranslatedData["es"]["hello"], memory.FakeTranslation("Hello", "es"); got != want {
+		t.Errorf("translation = %q, want %q", got, want)
+	}
+}
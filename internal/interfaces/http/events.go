@@ -0,0 +1,220 @@
+package http
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// eventHeartbeatInterval is how often idle event streams are kept alive
+const eventHeartbeatInterval = 15 * time.Second
+
+// eventSink writes request events to a streaming connection
+type eventSink interface {
+	// Send event to client
+	Send(event dto.RequestEventInfo) error
+
+	// Keep idle connection alive
+	Heartbeat() error
+}
+
+// StreamRequestEvents streams progress events of translation request
+// @Summary Stream translation request events
+// @Description Stream status transitions and per-key outcomes of translation request as Server-Sent Events, or over WebSocket when the connection asks for an upgrade. The first event is the current status with progress. The stream ends after the request reaches a final status
+// @Tags translations
+// @Produce text/event-stream
+// @Security ApiKeyAuth
+// @Param id path string true "Request ID" format(uuid)
+// @Success 200 {object} dto.RequestEventInfo "Stream of events"
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Router /api/v1/translations/{id}/events [get]
+func (h *Handler) StreamRequestEvents(c *fiber.Ctx) error {
+	requestID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Invalid request ID format",
+		})
+	}
+
+	if _, err := h.appService.GetTranslationRequest(c.Context(), requestID); err != nil {
+		return c.Status(http.StatusNotFound).JSON(dto.ErrorResponse{
+			Error: "Translation request not found",
+		})
+	}
+
+	if websocket.IsWebSocketUpgrade(c) {
+		return h.eventsWebSocket(c)
+	}
+
+	c.Set("Content-Type", "text/event-stream")
+	c.Set("Cache-Control", "no-cache")
+	c.Set("Connection", "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		if err := h.streamEvents(ctx, requestID, &sseSink{w: w}); err != nil {
+			fmt.Printf("Event stream of request %s ended: %v\n", requestID, err)
+		}
+	})
+
+	return nil
+}
+
+// serveEventsWebSocket streams request events over upgraded WebSocket connection
+func (h *Handler) serveEventsWebSocket(conn *websocket.Conn) {
+	requestID, err := uuid.Parse(conn.Params("id"))
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Client messages are ignored, reading detects disconnects
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	if err := h.streamEvents(ctx, requestID, &webSocketSink{conn: conn}); err != nil {
+		fmt.Printf("Event stream of request %s ended: %v\n", requestID, err)
+	}
+
+	conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+// streamEvents sends current status with progress, then live events until request reaches a final status
+func (h *Handler) streamEvents(ctx context.Context, requestID uuid.UUID, sink eventSink) error {
+	// Subscribe before reading current state, so no transition in between is missed
+	events, unsubscribe, err := h.appService.SubscribeRequestEvents(ctx, requestID)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe: %w", err)
+	}
+	defer unsubscribe()
+
+	request, err := h.appService.GetTranslationRequest(ctx, requestID)
+	if err != nil {
+		return fmt.Errorf("failed to get request: %w", err)
+	}
+
+	snapshot := dto.RequestEventInfo{
+		Type:      string(domainTranslation.EventTypeStatus),
+		RequestID: requestID.String(),
+		Status:    string(request.Status),
+		CreatedAt: time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	if progress, err := h.appService.GetRequestProgress(ctx, request); err == nil {
+		snapshot.Progress = toRequestProgressInfo(progress)
+	}
+	if err := sink.Send(snapshot); err != nil {
+		return err
+	}
+	if request.Status.IsFinal() {
+		return nil
+	}
+
+	heartbeat := time.NewTicker(eventHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-heartbeat.C:
+			if err := sink.Heartbeat(); err != nil {
+				return err
+			}
+		case event, ok := <-events:
+			if !ok {
+				return fmt.Errorf("event subscription closed")
+			}
+			if err := sink.Send(toRequestEventInfo(event)); err != nil {
+				return err
+			}
+			if event.Type == domainTranslation.EventTypeStatus && event.Status.IsFinal() {
+				return nil
+			}
+		}
+	}
+}
+
+// toRequestEventInfo converts request event to DTO format
+func toRequestEventInfo(event *domainTranslation.RequestEvent) dto.RequestEventInfo {
+	info := dto.RequestEventInfo{
+		Type:      string(event.Type),
+		RequestID: event.RequestID.String(),
+		Status:    string(event.Status),
+		Value:     event.Value,
+		CreatedAt: event.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+	if event.Result != nil {
+		info.Key = event.Result.Key
+		info.Language = event.Result.Language
+		info.Outcome = string(event.Result.Outcome)
+		info.Error = event.Result.Error
+	}
+
+	return info
+}
+
+// sseSink writes events in Server-Sent Events format
+type sseSink struct {
+	w *bufio.Writer
+}
+
+// Send writes event named after its type
+func (s *sseSink) Send(event dto.RequestEventInfo) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	if _, err := fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", event.Type, data); err != nil {
+		return err
+	}
+	return s.w.Flush()
+}
+
+// Heartbeat writes comment line ignored by clients
+func (s *sseSink) Heartbeat() error {
+	if _, err := s.w.WriteString(": keep-alive\n\n"); err != nil {
+		return err
+	}
+	return s.w.Flush()
+}
+
+// webSocketSink writes events as WebSocket JSON messages
+type webSocketSink struct {
+	conn *websocket.Conn
+}
+
+// Send writes event as JSON text message
+func (s *webSocketSink) Send(event dto.RequestEventInfo) error {
+	return s.conn.WriteJSON(event)
+}
+
+// Heartbeat writes ping control message
+func (s *webSocketSink) Heartbeat() error {
+	return s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(eventHeartbeatInterval))
+}
@@ -0,0 +1,185 @@
+package http_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/infrastructure/memory"
+	"translation/internal/interfaces/http/dto"
+
+	"github.com/fasthttp/websocket"
+	"github.com/google/uuid"
+)
+
+// streamEvents opens SSE stream of request in background and returns channel with all received events
+func (e *testEnv) streamEvents(id string) <-chan []dto.RequestEventInfo {
+	e.t.Helper()
+
+	result := make(chan []dto.RequestEventInfo, 1)
+	go func() {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/translations/"+id+"/events", nil)
+		req.Header.Set("Authorization", "Bearer "+testAPIKey)
+
+		resp, err := e.app.Test(req, -1)
+		if err != nil {
+			e.t.Errorf("event stream failed: %v", err)
+			result <- nil
+			return
+		}
+		defer resp.Body.Close()
+
+		if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+			e.t.Errorf("expected event stream, got %q", ct)
+		}
+
+		var events []dto.RequestEventInfo
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			data, found := strings.CutPrefix(scanner.Text(), "data: ")
+			if !found {
+				continue
+			}
+			var event dto.RequestEventInfo
+			if err := json.Unmarshal([]byte(data), &event); err != nil {
+				e.t.Errorf("failed to decode event %q: %v", data, err)
+				continue
+			}
+			events = append(events, event)
+		}
+		result <- events
+	}()
+
+	return result
+}
+
+// waitForSubscriber waits until request has an event subscriber
+func (e *testEnv) waitForSubscriber(id string) {
+	e.t.Helper()
+
+	requestID := uuid.MustParse(id)
+	deadline := time.Now().Add(5 * time.Second)
+	for e.events.Subscribers(requestID) == 0 {
+		if time.Now().After(deadline) {
+			e.t.Fatal("stream did not subscribe to request events")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// receiveEvents waits for stream to end
+func receiveEvents(t *testing.T, stream <-chan []dto.RequestEventInfo) []dto.RequestEventInfo {
+	t.Helper()
+
+	select {
+	case events := <-stream:
+		return events
+	case <-time.After(5 * time.Second):
+		t.Fatal("event stream did not end")
+		return nil
+	}
+}
+
+// checkEventSequence checks stream of request translating "hello" to Spanish from pending to completion
+func checkEventSequence(t *testing.T, events []dto.RequestEventInfo) {
+	t.Helper()
+
+	var statuses []string
+	var keyEvents []dto.RequestEventInfo
+	for _, event := range events {
+		switch event.Type {
+		case string(domainTranslation.EventTypeStatus):
+			statuses = append(statuses, event.Status)
+		case string(domainTranslation.EventTypeKey):
+			keyEvents = append(keyEvents, event)
+		}
+	}
+
+	want := []string{"pending", "processing", "completed"}
+	if strings.Join(statuses, ",") != strings.Join(want, ",") {
+		t.Errorf("expected status transitions %v, got %v", want, statuses)
+	}
+	if events[0].Progress == nil || events[0].Progress.Pending != 1 {
+		t.Errorf("expected first event to carry progress, got %+v", events[0])
+	}
+
+	if len(keyEvents) != 1 {
+		t.Fatalf("expected one key event, got %+v", keyEvents)
+	}
+	if got := keyEvents[0]; got.Key != "hello" || got.Language != "es" || got.Outcome != string(domainTranslation.KeyOutcomeTranslated) ||
+		got.Value != memory.FakeTranslation("Hello", "es") {
+		t.Errorf("unexpected key event: %+v", got)
+	}
+}
+
+func TestServerSentEvents(t *testing.T) {
+	env := newTestEnv(t)
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	stream := env.streamEvents(id)
+	env.waitForSubscriber(id)
+	env.startConsumer()
+
+	checkEventSequence(t, receiveEvents(t, stream))
+}
+
+func TestEventStreamOfFinishedRequest(t *testing.T) {
+	env := newTestEnv(t)
+	env.startConsumer()
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	env.waitForStatus(id, domainTranslation.StatusCompleted)
+
+	events := receiveEvents(t, env.streamEvents(id))
+	if len(events) != 1 || events[0].Status != "completed" || events[0].Progress.Done != 1 {
+		t.Errorf("expected single final status event, got %+v", events)
+	}
+
+	if status := env.do(http.MethodGet, "/api/v1/translations/"+uuid.NewString()+"/events", nil, nil); status != http.StatusNotFound {
+		t.Errorf("expected status 404 for unknown request, got %d", status)
+	}
+}
+
+func TestWebSocketEvents(t *testing.T) {
+	env := newTestEnv(t)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	go env.app.Listener(ln)
+	t.Cleanup(func() { env.app.Shutdown() })
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+
+	header := http.Header{"Authorization": {"Bearer " + testAPIKey}}
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+ln.Addr().String()+"/api/v1/translations/"+id+"/events", header)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+
+	env.waitForSubscriber(id)
+	env.startConsumer()
+
+	var events []dto.RequestEventInfo
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		var event dto.RequestEventInfo
+		if err := conn.ReadJSON(&event); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				t.Fatalf("unexpected read error: %v", err)
+			}
+			break
+		}
+		events = append(events, event)
+	}
+
+	checkEventSequence(t, events)
+}
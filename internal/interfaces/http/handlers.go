@@ -1,40 +1,61 @@
 package http
 
 import (
+	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"translation/internal/application/translation"
+	"translation/internal/config"
 	domainTranslation "translation/internal/domain/translation"
 	"translation/internal/interfaces/http/dto"
 
+	"github.com/gofiber/contrib/websocket"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 )
 
 // Handler represents HTTP handlers
 type Handler struct {
-	appService *translation.Service
+	appService      *translation.Service
+	syncConfig      config.SyncConfig
+	eventsWebSocket fiber.Handler
 }
 
 // NewHandler creates a new HTTP handler instance
-func NewHandler(appService *translation.Service) *Handler {
-	return &Handler{
+func NewHandler(appService *translation.Service, syncConfig config.SyncConfig) *Handler {
+	h := &Handler{
 		appService: appService,
+		syncConfig: syncConfig,
 	}
+	h.eventsWebSocket = websocket.New(h.serveEventsWebSocket)
+
+	return h
 }
 
 // CreateTranslationRequest creates a new translation request
 // @Summary Create translation request
-// @Description Create a new translation request and queue it for processing
+// @Description Create a new translation request and queue it for processing. Higher priority requests are processed first; chunks of one project are queued a few at a time, so a large request doesn't hold up other projects
 // @Tags translations
 // @Accept json
 // @Produce json
 // @Security ApiKeyAuth
 // @Param request body dto.CreateTranslationRequestRequest true "Translation request data"
+// @Param Idempotency-Key header string false "Repeating creation with the same key returns the response to the request created first"
+// @Success 200 {object} dto.CreateTranslationRequestResponse "Identical request in progress was returned (coalesce)"
 // @Success 201 {object} dto.CreateTranslationRequestResponse
+// @Header 200,201 {string} Idempotent-Replayed "true when response is a replay of the response to an earlier request with the same Idempotency-Key"
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /api/v1/translations [post]
 func (h *Handler) CreateTranslationRequest(c *fiber.Ctx) error {
@@ -59,35 +80,117 @@ func (h *Handler) CreateTranslationRequest(c *fiber.Ctx) error {
 		})
 	}
 
+	keys, characters := domainTranslation.RequestUsage(req.SourceData, req.Languages)
+	if ok, err := h.consumeQuotas(c, req.Project, keys, characters); !ok {
+		return err
+	}
+
 	// Create translation request
-	request, err := h.appService.CreateTranslationRequest(c.Context(), req.SourceData, req.Languages)
+	options := domainTranslation.RequestOptions{
+		Project:        req.Project,
+		CallbackURL:    req.CallbackURL,
+		Priority:       req.Priority,
+		IdempotencyKey: c.Get("Idempotency-Key"),
+		Coalesce:       req.Coalesce,
+		CreatedBy:      principalID(c),
+	}
+	result, err := h.appService.CreateTranslationRequest(c.Context(), req.SourceData, req.Languages, options)
+	// Only requests queued for translation are charged
+	if err != nil || !result.Created {
+		h.appService.RefundQuotas(c.Context(), req.Project, keys, characters)
+	}
 	if err != nil {
+		if err.Error() == "invalid idempotency key" {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: fmt.Sprintf("Idempotency-Key must be at most %d characters", domainTranslation.MaxIdempotencyKeyLength),
+			})
+		}
+		if err.Error() == "idempotency key was used for a different request" {
+			return c.Status(http.StatusUnprocessableEntity).JSON(dto.ErrorResponse{
+				Error: "Idempotency-Key was already used for a different request",
+			})
+		}
+		if err.Error() == "request with this idempotency key is in progress" {
+			return c.Status(http.StatusConflict).JSON(dto.ErrorResponse{
+				Error: "Request with this Idempotency-Key is still being created, retry later",
+			})
+		}
+		if err.Error() == "invalid callback URL" {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "Callback URL must be an absolute HTTP(S) URL",
+			})
+		}
+		if err.Error() == "invalid priority" {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: fmt.Sprintf("Priority must be between 0 and %d", domainTranslation.MaxPriority),
+			})
+		}
 		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
 			Error: fmt.Sprintf("Failed to create translation request: %v", err),
 		})
 	}
 
+	request := result.Request
+	auditChange(c, request.ID.String(), request.Project, nil, map[string]any{
+		"status":    string(request.Status),
+		"languages": request.Languages,
+		"keys":      len(request.SourceData),
+		"replayed":  result.Replayed,
+		"coalesced": result.Coalesced,
+	})
+
+	// Replays get the response of the first request as it was sent
+	if result.Replayed {
+		c.Set("Idempotent-Replayed", "true")
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		return c.Status(result.Response.Status).Send(result.Response.Body)
+	}
+
 	response := dto.CreateTranslationRequestResponse{
 		RequestID: request.ID.String(),
 		Status:    string(request.Status),
 		Message:   "Translation request created successfully and queued for processing",
 	}
+	status := http.StatusCreated
+	if result.Coalesced {
+		response.Coalesced = true
+		response.Message = "Identical translation request is already in progress"
+		status = http.StatusOK
+	}
 
-	return c.Status(http.StatusCreated).JSON(response)
+	body, err := json.Marshal(response)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to encode response: %v", err),
+		})
+	}
+	if options.IdempotencyKey != "" {
+		saved := &domainTranslation.IdempotentResponse{RequestID: request.ID, Status: status, Body: body}
+		if err := h.appService.SaveIdempotentResponse(c.Context(), options, saved); err != nil {
+			// Log error but don't fail the request, which was already created
+			fmt.Printf("Failed to save idempotent response of request %s: %v\n", request.ID, err)
+		}
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	return c.Status(status).Send(body)
 }
 
 // GetTranslationRequest gets request status by ID
 // @Summary Get translation request
-// @Description Get translation request status and details by ID
+// @Description Get translation request status, progress per language, per-key outcomes and details by ID
 // @Tags translations
 // @Accept json
 // @Produce json
 // @Security ApiKeyAuth
 // @Param id path string true "Request ID" format(uuid)
+// @Param outcome query string false "Only return key results with this outcome" Enums(translated, cached, failed, discarded)
 // @Success 200 {object} dto.GetTranslationRequestResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
 // @Router /api/v1/translations/{id} [get]
 func (h *Handler) GetTranslationRequest(c *fiber.Ctx) error {
 	requestIDStr := c.Params("id")
@@ -107,12 +210,17 @@ func (h *Handler) GetTranslationRequest(c *fiber.Ctx) error {
 	}
 
 	response := dto.GetTranslationRequestResponse{
-		RequestID:  request.ID.String(),
-		Status:     string(request.Status),
-		SourceData: request.SourceData,
-		Languages:  request.Languages,
-		CreatedAt:  request.CreatedAt.Format("2006-01-02T15:04:05Z"),
-		UpdatedAt:  request.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		RequestID:   request.ID.String(),
+		Status:      string(request.Status),
+		SourceData:  request.SourceData,
+		Languages:   request.Languages,
+		CreatedAt:   request.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:   request.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		Project:     request.Project,
+		CallbackURL: request.CallbackURL,
+		Priority:    request.Priority,
+		Error:       request.Error,
+		CreatedBy:   request.CreatedBy,
 	}
 
 	if request.CompletedAt != nil {
@@ -120,8 +228,17 @@ func (h *Handler) GetTranslationRequest(c *fiber.Ctx) error {
 		response.CompletedAt = &completedAt
 	}
 
+	progress, err := h.appService.GetRequestProgress(c.Context(), request)
+	if err != nil {
+		// Log error but don't fail the request
+		fmt.Printf("Failed to get request progress: %v\n", err)
+	} else {
+		response.Progress = toRequestProgressInfo(progress)
+		response.Results = toKeyResultInfos(progress.Results, c.Query("outcome"))
+	}
+
 	// Get translated data if request is completed
-	if request.Status == domainTranslation.StatusCompleted {
+	if request.Status == domainTranslation.StatusCompleted || request.Status == domainTranslation.StatusPartiallyCompleted {
 		translatedData, err := h.appService.GetTranslatedDataForRequestKeys(c.Context(), request.SourceData, request.Languages)
 		if err != nil {
 			// Log error but don't fail the request
@@ -134,6 +251,50 @@ func (h *Handler) GetTranslationRequest(c *fiber.Ctx) error {
 	return c.JSON(response)
 }
 
+// toRequestProgressInfo converts request progress to DTO format
+func toRequestProgressInfo(progress *domainTranslation.RequestProgress) *dto.RequestProgressInfo {
+	info := &dto.RequestProgressInfo{
+		LanguageProgressInfo: toLanguageProgressInfo(&progress.LanguageProgress),
+		Languages:            make(map[string]dto.LanguageProgressInfo, len(progress.Languages)),
+	}
+	for lang, languageProgress := range progress.Languages {
+		info.Languages[lang] = toLanguageProgressInfo(languageProgress)
+	}
+
+	return info
+}
+
+// toLanguageProgressInfo converts language progress to DTO format
+func toLanguageProgressInfo(progress *domainTranslation.LanguageProgress) dto.LanguageProgressInfo {
+	return dto.LanguageProgressInfo{
+		Total:     progress.Total,
+		Done:      progress.Done,
+		Failed:    progress.Failed,
+		Skipped:   progress.Skipped,
+		Discarded: progress.Discarded,
+		Pending:   progress.Pending(),
+	}
+}
+
+// toKeyResultInfos converts key results to DTO format, keeping only given outcome if set
+func toKeyResultInfos(results []*domainTranslation.KeyResult, outcome string) []dto.KeyResultInfo {
+	infos := make([]dto.KeyResultInfo, 0, len(results))
+	for _, result := range results {
+		if outcome != "" && string(result.Outcome) != outcome {
+			continue
+		}
+		infos = append(infos, dto.KeyResultInfo{
+			Key:       result.Key,
+			Language:  result.Language,
+			Outcome:   string(result.Outcome),
+			Error:     result.Error,
+			UpdatedAt: result.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		})
+	}
+
+	return infos
+}
+
 // HealthCheck checks service status
 // @Summary Health check
 // @Description Check if the service is running
@@ -159,7 +320,9 @@ func (h *Handler) HealthCheck(c *fiber.Ctx) error {
 // @Param key path string true "Translation key"
 // @Success 204
 // @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /api/v1/translations/{key} [delete]
 func (h *Handler) DeleteTranslationKey(c *fiber.Ctx) error {
@@ -170,7 +333,13 @@ func (h *Handler) DeleteTranslationKey(c *fiber.Ctx) error {
 		})
 	}
 
-	err := h.appService.DeleteTranslationKey(c.Context(), key)
+	// Audit log keeps what was deleted
+	var before map[string]any
+	if existing, err := h.appService.GetTranslationKey(c.Context(), key); err == nil {
+		before = map[string]any{"value": existing.Value, "translations": existing.Translations}
+	}
+
+	err := h.appService.DeleteTranslationKey(changeContext(c), key)
 	if err != nil {
 		if err.Error() == "translation key not found" {
 			return c.Status(http.StatusNotFound).JSON(dto.ErrorResponse{
@@ -181,6 +350,7 @@ func (h *Handler) DeleteTranslationKey(c *fiber.Ctx) error {
 			Error: err.Error(),
 		})
 	}
+	auditChange(c, key, "", before, nil)
 
 	return c.SendStatus(http.StatusNoContent)
 }
@@ -196,6 +366,8 @@ func (h *Handler) DeleteTranslationKey(c *fiber.Ctx) error {
 // @Success 200 {object} dto.CacheTranslationsResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /api/v1/translations/cache [post]
 func (h *Handler) CacheTranslations(c *fiber.Ctx) error {
@@ -214,13 +386,24 @@ func (h *Handler) CacheTranslations(c *fiber.Ctx) error {
 		})
 	}
 
+	// Audit log keeps translations that get overwritten
+	var before map[string]any
+	if overwritten := h.overwrittenTranslations(c, req.Translations); len(overwritten) > 0 {
+		before = map[string]any{"translations": overwritten}
+	}
+
 	// Cache translations
-	result, err := h.appService.CacheTranslations(c.Context(), req.Translations)
+	result, err := h.appService.CacheTranslations(changeContext(c), req.Translations)
 	if err != nil {
 		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
 			Error: fmt.Sprintf("Failed to cache translations: %v", err),
 		})
 	}
+	auditChange(c, "", "", before, map[string]any{
+		"keys":         result.SuccessCount,
+		"languages":    slices.Sorted(maps.Keys(req.Translations)),
+		"skipped_keys": result.SkippedKeys,
+	})
 
 	// Check if there were any skipped keys
 	if len(result.SkippedKeys) > 0 {
@@ -247,17 +430,21 @@ func (h *Handler) CacheTranslations(c *fiber.Ctx) error {
 
 // CancelTranslationRequest cancels a translation request by ID
 // @Summary Cancel translation request
-// @Description Cancel a translation request by ID if it's still pending or processing
+// @Description Cancel a translation request by ID if it's still pending or processing. Workers stop translating it
+// @Description right away, translations it already saved are kept unless discard_translations is set.
 // @Tags translations
 // @Accept json
 // @Produce json
 // @Security ApiKeyAuth
 // @Param id path string true "Request ID" format(uuid)
+// @Param request body dto.CancelTranslationRequestRequest false "Cancellation options"
 // @Success 200 {object} dto.CancelTranslationRequestResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 409 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /api/v1/translations/{id}/cancel [post]
 func (h *Handler) CancelTranslationRequest(c *fiber.Ctx) error {
@@ -270,19 +457,27 @@ func (h *Handler) CancelTranslationRequest(c *fiber.Ctx) error {
 		})
 	}
 
-	err = h.appService.CancelTranslationRequest(c.Context(), requestID)
+	var req dto.CancelTranslationRequestRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "Invalid request body",
+			})
+		}
+	}
+
+	project, before := h.auditedRequest(c, requestID)
+	discarded, err := h.appService.CancelTranslationRequest(c.Context(), requestID, req.DiscardTranslations)
 	if err != nil {
-		// Check if it's a business logic error (cannot be cancelled)
-		if err.Error() == "request cannot be cancelled in status: completed" ||
-			err.Error() == "request cannot be cancelled in status: failed" ||
-			err.Error() == "request cannot be cancelled in status: cancelled" {
+		// Check if it's a business logic error (cannot be cancelled in a final status)
+		if strings.HasPrefix(err.Error(), "request cannot be cancelled in status: ") {
 			return c.Status(http.StatusConflict).JSON(dto.ErrorResponse{
 				Error: err.Error(),
 			})
 		}
 
 		// Check if request not found
-		if err.Error() == "failed to get request: translation request not found" {
+		if err.Error() == "failed to get request: request not found" {
 			return c.Status(http.StatusNotFound).JSON(dto.ErrorResponse{
 				Error: "Translation request not found",
 			})
@@ -293,15 +488,253 @@ func (h *Handler) CancelTranslationRequest(c *fiber.Ctx) error {
 		})
 	}
 
+	auditChange(c, requestID.String(), project, before, map[string]any{
+		"status":    string(domainTranslation.StatusCancelled),
+		"discarded": discarded,
+	})
+
 	response := dto.CancelTranslationRequestResponse{
 		RequestID: requestID.String(),
 		Status:    "cancelled",
 		Message:   "Translation request cancelled successfully",
+		Discarded: discarded,
 	}
 
 	return c.JSON(response)
 }
 
+// RetryTranslationRequest queues finished translation request again
+// @Summary Retry translation request
+// @Description Queue a failed, partially completed, completed or cancelled request again. Only key languages that failed or whose translation is missing are translated, unless keys or languages force translating again translations that exist: the given keys in the given languages, all keys or all languages of the request when one of them is omitted. Retried key languages are charged to quotas of the project.
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Request ID" format(uuid)
+// @Param request body dto.RetryTranslationRequestRequest false "Keys and languages to translate again"
+// @Success 202 {object} dto.RetryTranslationRequestResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/translations/{id}/retry [post]
+func (h *Handler) RetryTranslationRequest(c *fiber.Ctx) error {
+	requestID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Invalid request ID format",
+		})
+	}
+
+	var req dto.RetryTranslationRequestRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "Invalid request body",
+			})
+		}
+	}
+
+	options := domainTranslation.RetryOptions{
+		ForceKeys:      req.Keys,
+		ForceLanguages: req.Languages,
+	}
+	project, before := h.auditedRequest(c, requestID)
+
+	// Retried key languages are charged to quotas of the project like new requests
+	plan, err := h.appService.PlanRetry(c.Context(), requestID, options)
+	if err != nil {
+		return retryError(c, err)
+	}
+	keys, characters := plan.Usage()
+	if ok, err := h.consumeQuotas(c, plan.Request.Project, keys, characters); !ok {
+		return err
+	}
+
+	result, err := h.appService.RetryTranslationRequest(changeContext(c), requestID, options)
+	if err != nil {
+		h.appService.RefundQuotas(c.Context(), plan.Request.Project, keys, characters)
+		return retryError(c, err)
+	}
+
+	auditChange(c, requestID.String(), project, before, map[string]any{
+		"status":  string(result.Request.Status),
+		"retried": result.Retried,
+	})
+
+	response := dto.RetryTranslationRequestResponse{
+		RequestID: requestID.String(),
+		Status:    string(result.Request.Status),
+		Retried:   result.Retried,
+		Message:   "Translation request queued for retry",
+	}
+
+	return c.Status(http.StatusAccepted).JSON(response)
+}
+
+// retryError responds with error of retrying translation request
+func retryError(c *fiber.Ctx, err error) error {
+	if err.Error() == "failed to get request: request not found" {
+		return c.Status(http.StatusNotFound).JSON(dto.ErrorResponse{
+			Error: "Translation request not found",
+		})
+	}
+	if err.Error() == "retried keys and languages must belong to the request" {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Keys and languages must belong to the request",
+		})
+	}
+	if err.Error() == "request has nothing to retry" ||
+		err.Error() == "request cannot be retried in status: pending" ||
+		err.Error() == "request cannot be retried in status: processing" {
+		return c.Status(http.StatusConflict).JSON(dto.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+	return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error: fmt.Sprintf("Failed to retry translation request: %v", err),
+	})
+}
+
+// ListTranslationRequests lists translation requests
+// @Summary List translation requests
+// @Description List stored and archived translation requests matching filters, one page at a time. Archived requests only keep their summary
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param status query string false "Comma-separated statuses to list"
+// @Param project query string false "Project to list requests of"
+// @Param created_by query string false "ID of API key that created requests"
+// @Param created_from query string false "RFC 3339 timestamp of the earliest creation time"
+// @Param created_to query string false "RFC 3339 timestamp of the latest creation time"
+// @Param sort query string false "created_at, updated_at or priority, prefixed with - for descending order" default(-created_at)
+// @Param offset query int false "Number of requests to skip" default(0)
+// @Param limit query int false "Maximum number of requests to return, up to 500" default(50)
+// @Success 200 {object} dto.ListTranslationRequestsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/translations [get]
+func (h *Handler) ListTranslationRequests(c *fiber.Ctx) error {
+	filter := domainTranslation.RequestFilter{
+		Project:   c.Query("project"),
+		CreatedBy: c.Query("created_by"),
+	}
+
+	// Principals allowed in some projects only list requests of those projects
+	filter.Projects = visibleProjects(c, domainTranslation.ActionReadRequests)
+
+	if param := c.Query("status"); param != "" {
+		for _, status := range strings.Split(param, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				filter.Statuses = append(filter.Statuses, domainTranslation.RequestStatus(status))
+			}
+		}
+	}
+
+	var err error
+	if param := c.Query("created_from"); param != "" {
+		if filter.CreatedFrom, err = time.Parse(time.RFC3339, param); err != nil {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "created_from must be in RFC 3339 format",
+			})
+		}
+	}
+	if param := c.Query("created_to"); param != "" {
+		if filter.CreatedTo, err = time.Parse(time.RFC3339, param); err != nil {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "created_to must be in RFC 3339 format",
+			})
+		}
+	}
+
+	sortParam := c.Query("sort", "-created_at")
+	filter.Descending = strings.HasPrefix(sortParam, "-")
+	filter.Sort = domainTranslation.RequestSort(strings.TrimPrefix(sortParam, "-"))
+
+	if filter.Offset, err = strconv.Atoi(c.Query("offset", "0")); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("offset must be non-negative and limit an integer from 1 to %d", domainTranslation.MaxRequestPageSize),
+		})
+	}
+	if filter.Limit, err = strconv.Atoi(c.Query("limit", strconv.Itoa(domainTranslation.DefaultRequestPageSize))); err != nil || filter.Limit < 1 {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("offset must be non-negative and limit an integer from 1 to %d", domainTranslation.MaxRequestPageSize),
+		})
+	}
+
+	page, err := h.appService.ListTranslationRequests(c.Context(), filter)
+	if err != nil {
+		switch err.Error() {
+		case "invalid sort":
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "sort must be one of created_at, updated_at, priority, optionally prefixed with -",
+			})
+		case "invalid page":
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: fmt.Sprintf("offset must be non-negative and limit an integer from 1 to %d", domainTranslation.MaxRequestPageSize),
+			})
+		case "invalid status":
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "status must be one of pending, processing, completed, partially_completed, failed, cancelled",
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to list translation requests: %v", err),
+		})
+	}
+
+	summaries := make([]dto.TranslationRequestSummary, 0, len(page.Requests))
+	for _, summary := range page.Requests {
+		summaries = append(summaries, toRequestSummary(summary))
+	}
+
+	return c.JSON(dto.ListTranslationRequestsResponse{
+		Requests: summaries,
+		Count:    len(summaries),
+		Total:    page.Total,
+		Offset:   filter.Offset,
+		Limit:    filter.Limit,
+	})
+}
+
+// toRequestSummary converts request summary to DTO format
+func toRequestSummary(summary *domainTranslation.RequestSummary) dto.TranslationRequestSummary {
+	info := dto.TranslationRequestSummary{
+		RequestID: summary.ID.String(),
+		Status:    string(summary.Status),
+		Project:   summary.Project,
+		CreatedBy: summary.CreatedBy,
+		Languages: summary.Languages,
+		Keys:      summary.Keys,
+		Priority:  summary.Priority,
+		Error:     summary.Error,
+		CreatedAt: summary.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt: summary.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		Archived:  summary.ArchivedAt != nil,
+	}
+	if summary.Progress != nil {
+		progress := toLanguageProgressInfo(summary.Progress)
+		info.Progress = &progress
+	}
+	if summary.CompletedAt != nil {
+		completedAt := summary.CompletedAt.Format("2006-01-02T15:04:05Z")
+		info.CompletedAt = &completedAt
+	}
+	if summary.ArchivedAt != nil {
+		archivedAt := summary.ArchivedAt.Format("2006-01-02T15:04:05Z")
+		info.ArchivedAt = &archivedAt
+	}
+
+	return info
+}
+
 // GetIncompleteRequests gets all incomplete translation requests
 // @Summary Get incomplete requests
 // @Description Get all translation requests that are not completed, failed, or cancelled
@@ -311,6 +744,8 @@ func (h *Handler) CancelTranslationRequest(c *fiber.Ctx) error {
 // @Security ApiKeyAuth
 // @Success 200 {object} dto.GetIncompleteRequestsResponse
 // @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /api/v1/translations/incomplete [get]
 func (h *Handler) GetIncompleteRequests(c *fiber.Ctx) error {
@@ -324,6 +759,9 @@ func (h *Handler) GetIncompleteRequests(c *fiber.Ctx) error {
 	// Convert to DTO format
 	var incompleteRequests []dto.IncompleteRequestInfo
 	for _, request := range requests {
+		if !allowedIn(c, domainTranslation.ActionReadRequests, request.Project) {
+			continue
+		}
 		incompleteRequests = append(incompleteRequests, dto.IncompleteRequestInfo{
 			RequestID:  request.ID.String(),
 			Status:     string(request.Status),
@@ -341,3 +779,190 @@ func (h *Handler) GetIncompleteRequests(c *fiber.Ctx) error {
 
 	return c.JSON(response)
 }
+
+// GetKeyHistory gets versioned history of translation key
+// @Summary Get translation key history
+// @Description Get versioned history of key source value and translations, optionally filtered by language ("source" for the source value)
+// @Tags history
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param key path string true "Translation key"
+// @Param language query string false "Language code or \"source\""
+// @Success 200 {object} dto.GetKeyHistoryResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/translations/history/{key} [get]
+func (h *Handler) GetKeyHistory(c *fiber.Ctx) error {
+	key := c.Params("key")
+
+	history, err := h.appService.GetKeyHistory(c.Context(), key, c.Query("language"))
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to get key history: %v", err),
+		})
+	}
+
+	response := dto.GetKeyHistoryResponse{
+		Key:     key,
+		History: toHistoryEntryInfos(history),
+		Count:   len(history),
+	}
+
+	return c.JSON(response)
+}
+
+// RollbackTranslations rolls a key, a language or all translations back to a point in time
+// @Summary Roll back translations
+// @Description Restore source values and translations to their state at given timestamp. Limit the scope with key and/or language, omit both to roll back everything
+// @Tags history
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.RollbackTranslationsRequest true "Rollback scope and timestamp"
+// @Success 200 {object} dto.RollbackTranslationsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/translations/rollback [post]
+func (h *Handler) RollbackTranslations(c *fiber.Ctx) error {
+	var req dto.RollbackTranslationsRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Invalid request body",
+		})
+	}
+
+	at, err := time.Parse(time.RFC3339, req.Timestamp)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Timestamp must be in RFC 3339 format",
+		})
+	}
+
+	result, err := h.appService.RollbackTranslations(changeContext(c), req.Key, req.Language, at)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to roll back translations: %v", err),
+		})
+	}
+
+	auditChange(c, req.Key, "", nil, map[string]any{
+		"language":  req.Language,
+		"timestamp": req.Timestamp,
+		"changes":   len(result.Changes),
+	})
+
+	response := dto.RollbackTranslationsResponse{
+		Message: "Translations rolled back successfully",
+		Count:   len(result.Changes),
+		Changes: toHistoryEntryInfos(result.Changes),
+	}
+
+	return c.JSON(response)
+}
+
+// GetChanges gets source values and translations changed since a sequence or timestamp
+// @Summary Get changes since a sequence
+// @Description Get net changes of source values ("source" language) and translations recorded after given sequence or timestamp. Deleted values are returned as tombstones. Pass the returned sequence as since on the next call to sync incrementally
+// @Tags history
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param since query int false "Sequence to get changes after, 0 returns all changes"
+// @Param since_time query string false "RFC 3339 timestamp to get changes after, used instead of since"
+// @Param languages query string false "Comma-separated language codes to limit changes to"
+// @Success 200 {object} dto.GetChangesResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/translations/changes [get]
+func (h *Handler) GetChanges(c *fiber.Ctx) error {
+	var languages []string
+	if param := c.Query("languages"); param != "" {
+		for _, lang := range strings.Split(param, ",") {
+			if lang = strings.TrimSpace(lang); lang != "" {
+				languages = append(languages, lang)
+			}
+		}
+	}
+
+	var delta *domainTranslation.Delta
+	var err error
+
+	if sinceTime := c.Query("since_time"); sinceTime != "" {
+		at, parseErr := time.Parse(time.RFC3339, sinceTime)
+		if parseErr != nil {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "since_time must be in RFC 3339 format",
+			})
+		}
+		delta, err = h.appService.GetChangesSinceTime(c.Context(), at, languages)
+	} else {
+		since, parseErr := strconv.ParseInt(c.Query("since", "0"), 10, 64)
+		if parseErr != nil || since < 0 {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "since must be a non-negative sequence number",
+			})
+		}
+		delta, err = h.appService.GetChangesSince(c.Context(), since, languages)
+	}
+
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to get changes: %v", err),
+		})
+	}
+
+	changes := make([]dto.ChangeInfo, 0, len(delta.Changes))
+	for _, change := range delta.Changes {
+		changes = append(changes, dto.ChangeInfo{
+			Key:      change.Key,
+			Language: change.Language,
+			Value:    change.Value,
+			Deleted:  change.Deleted,
+			Sequence: change.Sequence,
+		})
+	}
+
+	response := dto.GetChangesResponse{
+		FromSequence: delta.FromSequence,
+		Sequence:     delta.Sequence,
+		Changes:      changes,
+		Count:        len(changes),
+	}
+
+	return c.JSON(response)
+}
+
+// toHistoryEntryInfos converts history entries to DTO format
+func toHistoryEntryInfos(entries []*domainTranslation.HistoryEntry) []dto.HistoryEntryInfo {
+	infos := make([]dto.HistoryEntryInfo, 0, len(entries))
+	for _, entry := range entries {
+		info := dto.HistoryEntryInfo{
+			Key:           entry.Key,
+			Language:      entry.Language,
+			Version:       entry.Version,
+			Action:        string(entry.Action),
+			Value:         entry.Value,
+			PreviousValue: entry.PreviousValue,
+			Provider:      entry.Source.Provider,
+			Model:         entry.Source.Model,
+			User:          entry.Source.User,
+			CreatedAt:     entry.CreatedAt.UTC().Format(time.RFC3339Nano),
+		}
+		if entry.Source.RequestID != nil {
+			info.RequestID = entry.Source.RequestID.String()
+		}
+		infos = append(infos, info)
+	}
+
+	return infos
+}
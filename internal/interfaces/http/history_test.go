@@ -0,0 +1,209 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	domainTranslation "translation/internal/domain/translation"
+	httpInterface "translation/internal/interfaces/http"
+	"translation/internal/interfaces/http/dto"
+)
+
+// cacheTranslations imports translations through the API
+func (e *testEnv) cacheTranslations(translations map[string]map[string]string) {
+	e.t.Helper()
+
+	status := e.do(http.MethodPost, "/api/v1/translations/cache", dto.CacheTranslationsRequest{
+		Translations: translations,
+	}, nil)
+	if status != http.StatusOK {
+		e.t.Fatalf("expected status 200, got %d", status)
+	}
+}
+
+// rollback rolls translations back through the API
+func (e *testEnv) rollback(key, language string, at time.Time) dto.RollbackTranslationsResponse {
+	e.t.Helper()
+
+	var resp dto.RollbackTranslationsResponse
+	status := e.do(http.MethodPost, "/api/v1/translations/rollback", dto.RollbackTranslationsRequest{
+		Key:       key,
+		Language:  language,
+		Timestamp: at.Format(time.RFC3339Nano),
+	}, &resp)
+	if status != http.StatusOK {
+		e.t.Fatalf("expected status 200, got %d", status)
+	}
+
+	return resp
+}
+
+// checkpoint returns a moment strictly between already made and upcoming changes
+func checkpoint() time.Time {
+	time.Sleep(2 * time.Millisecond)
+	at := time.Now()
+	time.Sleep(2 * time.Millisecond)
+	return at
+}
+
+// storedKey reads translation key directly from storage, nil if it doesn't exist
+func (e *testEnv) storedKey(key string) *domainTranslation.TranslationKey {
+	stored, err := e.repo.GetTranslationKey(context.Background(), key)
+	if err != nil {
+		return nil
+	}
+	return stored
+}
+
+func TestKeyHistoryRecordsProvenance(t *testing.T) {
+	env := newTestEnv(t)
+	env.startConsumer()
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	env.waitForStatus(id, domainTranslation.StatusCompleted)
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello"},
+		"es": {"hello": "¡Hola!"},
+	})
+
+	var resp dto.GetKeyHistoryResponse
+	if status := env.do(http.MethodGet, "/api/v1/translations/history/hello?language=es", nil, &resp); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if resp.Count != 2 {
+		t.Fatalf("expected 2 Spanish history entries, got %+v", resp.History)
+	}
+
+	created, updated := resp.History[0], resp.History[1]
+	if created.Action != string(domainTranslation.HistoryActionCreated) || created.Provider != "fake" ||
+		created.Model != "deterministic" || created.RequestID != id || created.User != "" {
+		t.Errorf("unexpected provenance of machine translation: %+v", created)
+	}
+	if updated.Action != string(domainTranslation.HistoryActionUpdated) || updated.Provider != "import" ||
+		updated.PreviousValue != created.Value || updated.Value != "¡Hola!" || updated.User != httpInterface.APIKeyID(testAPIKey) {
+		t.Errorf("unexpected import entry: %+v", updated)
+	}
+	if updated.Version <= created.Version {
+		t.Errorf("expected increasing versions, got %d then %d", created.Version, updated.Version)
+	}
+
+	resp = dto.GetKeyHistoryResponse{}
+	env.do(http.MethodGet, "/api/v1/translations/history/hello?language=source", nil, &resp)
+	if resp.Count != 1 || resp.History[0].Value != "Hello" || resp.History[0].RequestID != id {
+		t.Errorf("unexpected source value history: %+v", resp.History)
+	}
+}
+
+func TestRollbackLanguage(t *testing.T) {
+	env := newTestEnv(t)
+
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello"},
+		"es": {"hello": "Hola"},
+		"fr": {"hello": "Bonjour"},
+	})
+	at := checkpoint()
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello", "bye": "Bye"},
+		"es": {"hello": "Hola!!!", "bye": "Adiós"},
+		"fr": {"hello": "Salut"},
+	})
+
+	resp := env.rollback("", "es", at)
+	if resp.Count != 2 {
+		t.Errorf("expected 2 changes, got %+v", resp.Changes)
+	}
+
+	hello := env.storedKey("hello")
+	if hello.Translations["es"] != "Hola" {
+		t.Errorf("expected Spanish translation to be restored, got %q", hello.Translations["es"])
+	}
+	if hello.Translations["fr"] != "Salut" {
+		t.Errorf("expected French translation to be kept, got %q", hello.Translations["fr"])
+	}
+
+	bye := env.storedKey("bye")
+	if bye == nil {
+		t.Fatal("expected key created after timestamp to be kept by language rollback")
+	}
+	if _, exists := bye.Translations["es"]; exists {
+		t.Errorf("expected Spanish translation created after timestamp to be removed")
+	}
+
+	var history dto.GetKeyHistoryResponse
+	env.do(http.MethodGet, "/api/v1/translations/history/hello?language=es", nil, &history)
+	if last := history.History[len(history.History)-1]; last.Provider != "rollback" || last.Value != "Hola" || last.User != httpInterface.APIKeyID(testAPIKey) {
+		t.Errorf("expected rollback to be recorded in history, got %+v", last)
+	}
+}
+
+func TestRollbackEverything(t *testing.T) {
+	env := newTestEnv(t)
+
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello"},
+		"es": {"hello": "Hola"},
+	})
+	at := checkpoint()
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello there", "bye": "Bye"},
+		"es": {"hello": "Hola a todos"},
+	})
+
+	env.rollback("", "", at)
+
+	hello := env.storedKey("hello")
+	if hello.Value != "Hello" || hello.Translations["es"] != "Hola" {
+		t.Errorf("expected key to be restored, got %+v", hello)
+	}
+	if env.storedKey("bye") != nil {
+		t.Error("expected key created after timestamp to be removed")
+	}
+}
+
+func TestRollbackRestoresDeletedKey(t *testing.T) {
+	env := newTestEnv(t)
+	idp := env.useIdentityProvider()
+
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello", "bye": "Bye"},
+		"es": {"hello": "Hola", "bye": "Adiós"},
+	})
+	at := checkpoint()
+
+	// Changes are attributed to users deleting keys
+	dev := idp.sign("RS256", idp.claims("dana", map[string]any{"roles": []string{"developer"}}))
+	for _, key := range []string{"hello", "bye"} {
+		if status := env.doAs(dev, http.MethodDelete, "/api/v1/translations/"+key, nil, nil); status != http.StatusNoContent {
+			t.Fatalf("expected status 204, got %d", status)
+		}
+	}
+	var history dto.GetKeyHistoryResponse
+	env.do(http.MethodGet, "/api/v1/translations/history/bye", nil, &history)
+	if last := history.History[len(history.History)-1]; last.Action != string(domainTranslation.HistoryActionDeleted) || last.User != "user:dana" {
+		t.Errorf("expected deletion attributed to user, got %+v", last)
+	}
+
+	env.rollback("hello", "", at)
+
+	hello := env.storedKey("hello")
+	if hello == nil || hello.Value != "Hello" || hello.Translations["es"] != "Hola" {
+		t.Errorf("expected deleted key to be restored, got %+v", hello)
+	}
+	if env.storedKey("bye") != nil {
+		t.Error("expected rollback scoped to one key to leave other keys untouched")
+	}
+}
+
+func TestRollbackRejectsInvalidTimestamp(t *testing.T) {
+	env := newTestEnv(t)
+
+	status := env.do(http.MethodPost, "/api/v1/translations/rollback", dto.RollbackTranslationsRequest{
+		Timestamp: "yesterday",
+	}, nil)
+	if status != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", status)
+	}
+}
@@ -0,0 +1,229 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+)
+
+// createWithKey creates translation request with Idempotency-Key, returning status, response and
+// whether the response was a replay
+func (e *testEnv) createWithKey(key string, body dto.CreateTranslationRequestRequest) (int, dto.CreateTranslationRequestResponse, bool) {
+	e.t.Helper()
+
+	status, data, replayed := e.createWithKeyRaw(key, body)
+	var out dto.CreateTranslationRequestResponse
+	json.Unmarshal(data, &out)
+
+	return status, out, replayed
+}
+
+// createWithKeyRaw creates translation request with Idempotency-Key, returning status, response body as sent
+// and whether the response was a replay
+func (e *testEnv) createWithKeyRaw(key string, body dto.CreateTranslationRequestRequest) (int, []byte, bool) {
+	e.t.Helper()
+
+	return e.createWithKeyAs(testAPIKey, key, body)
+}
+
+// createWithKeyAs creates translation request with Idempotency-Key authorized by token, like createWithKeyRaw
+func (e *testEnv) createWithKeyAs(token string, key string, body dto.CreateTranslationRequestRequest) (int, []byte, bool) {
+	e.t.Helper()
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		e.t.Fatalf("failed to marshal body: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/translations", bytes.NewReader(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	if key != "" {
+		req.Header.Set("Idempotency-Key", key)
+	}
+
+	resp, err := e.app.Test(req, -1)
+	if err != nil {
+		e.t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	data, err = io.ReadAll(resp.Body)
+	if err != nil {
+		e.t.Fatalf("failed to read response: %v", err)
+	}
+
+	return resp.StatusCode, data, resp.Header.Get("Idempotent-Replayed") == "true"
+}
+
+func TestIdempotencyKeyReplaysRequest(t *testing.T) {
+	env := newTestEnv(t)
+	body := dto.CreateTranslationRequestRequest{
+		SourceData: map[string]string{"hello": "Hello"},
+		Languages:  []string{"es"},
+	}
+
+	status, first, replayed := env.createWithKey("ci-build-42", body)
+	if status != http.StatusCreated || replayed {
+		t.Fatalf("expected new request with status 201, got %d (replayed %v)", status, replayed)
+	}
+
+	status, second, replayed := env.createWithKey("ci-build-42", body)
+	if status != http.StatusCreated || !replayed {
+		t.Fatalf("expected replay with status 201, got %d (replayed %v)", status, replayed)
+	}
+	if second.RequestID != first.RequestID {
+		t.Errorf("expected replay to return request %s, got %s", first.RequestID, second.RequestID)
+	}
+
+	// Another key creates another request
+	if _, other, _ := env.createWithKey("ci-build-43", body); other.RequestID == first.RequestID {
+		t.Error("expected different idempotency key to create a new request")
+	}
+
+	if queued := env.getQueueInfo().Queued; queued != 2 {
+		t.Errorf("expected replay not to be queued, got %d queued tasks", queued)
+	}
+}
+
+func TestIdempotencyKeyReplaysOriginalResponse(t *testing.T) {
+	env := newTestEnv(t)
+	body := dto.CreateTranslationRequestRequest{
+		SourceData: map[string]string{"hello": "Hello"},
+		Languages:  []string{"es"},
+		Coalesce:   true,
+	}
+
+	status, first, _ := env.createWithKeyRaw("ci-build-1", body)
+	coalescedStatus, coalesced, _ := env.createWithKeyRaw("ci-build-2", body)
+	if coalescedStatus != http.StatusOK {
+		t.Fatalf("expected request to be coalesced with status 200, got %d", coalescedStatus)
+	}
+
+	// Replays after the request finished get the responses as they were sent, not the current request
+	var created dto.CreateTranslationRequestResponse
+	json.Unmarshal(first, &created)
+	env.startConsumer()
+	env.waitForStatus(created.RequestID, domainTranslation.StatusCompleted)
+
+	replayStatus, replay, replayed := env.createWithKeyRaw("ci-build-1", body)
+	if replayStatus != status || !bytes.Equal(replay, first) || !replayed {
+		t.Errorf("expected replay of %d %s, got %d %s (replayed %v)", status, first, replayStatus, replay, replayed)
+	}
+	replayStatus, replay, replayed = env.createWithKeyRaw("ci-build-2", body)
+	if replayStatus != coalescedStatus || !bytes.Equal(replay, coalesced) || !replayed {
+		t.Errorf("expected replay of %d %s, got %d %s (replayed %v)", coalescedStatus, coalesced, replayStatus, replay, replayed)
+	}
+}
+
+func TestIdempotencyKeyIsScopedToCaller(t *testing.T) {
+	env := newTestEnv(t)
+	body := dto.CreateTranslationRequestRequest{
+		SourceData: map[string]string{"hello": "Hello"},
+		Languages:  []string{"es"},
+		Project:    "mobile-app",
+	}
+	ios := env.createAPIKey(dto.CreateAPIKeyRequest{Name: "ios-ci", Projects: []string{"mobile-app"}, Permissions: []string{"translate"}})
+	android := env.createAPIKey(dto.CreateAPIKeyRequest{Name: "android-ci", Projects: []string{"mobile-app"}, Permissions: []string{"translate"}})
+
+	_, first, _ := env.createWithKeyAs(ios.Key, "build-1", body)
+	status, second, replayed := env.createWithKeyAs(android.Key, "build-1", body)
+	if status != http.StatusCreated || replayed {
+		t.Fatalf("expected another caller's key to create a new request, got %d (replayed %v)", status, replayed)
+	}
+
+	var created, other dto.CreateTranslationRequestResponse
+	json.Unmarshal(first, &created)
+	json.Unmarshal(second, &other)
+	if other.RequestID == created.RequestID {
+		t.Errorf("expected callers sharing a project not to get each other's request %s", created.RequestID)
+	}
+
+	// Each caller still gets its own request replayed
+	if _, replay, replayed := env.createWithKeyAs(ios.Key, "build-1", body); !replayed || !bytes.Equal(replay, first) {
+		t.Errorf("expected replay of %s, got %s (replayed %v)", first, replay, replayed)
+	}
+}
+
+func TestIdempotencyKeyRejectsDifferentRequest(t *testing.T) {
+	env := newTestEnv(t)
+
+	env.createWithKey("ci-build-42", dto.CreateTranslationRequestRequest{
+		SourceData: map[string]string{"hello": "Hello"},
+		Languages:  []string{"es"},
+	})
+
+	status, _, _ := env.createWithKey("ci-build-42", dto.CreateTranslationRequestRequest{
+		SourceData: map[string]string{"hello": "Hello"},
+		Languages:  []string{"fr"},
+	})
+	if status != http.StatusUnprocessableEntity {
+		t.Errorf("expected status 422, got %d", status)
+	}
+}
+
+func TestCoalesceIdenticalRequestsInProgress(t *testing.T) {
+	env := newTestEnv(t)
+	body := dto.CreateTranslationRequestRequest{
+		SourceData: map[string]string{"hello": "Hello", "bye": "Bye"},
+		Languages:  []string{"es", "fr"},
+		Project:    "mobile-app",
+		Coalesce:   true,
+	}
+
+	status, first, _ := env.createWithKey("", body)
+	if status != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d", status)
+	}
+
+	// Order of languages doesn't matter
+	body.Languages = []string{"fr", "es"}
+	status, second, _ := env.createWithKey("", body)
+	if status != http.StatusOK || !second.Coalesced || second.RequestID != first.RequestID {
+		t.Fatalf("expected request to be coalesced onto %s, got status %d and %+v", first.RequestID, status, second)
+	}
+
+	// Requests of other projects and requests that don't ask to coalesce are separate
+	body.Project = "web-app"
+	if _, other, _ := env.createWithKey("", body); other.Coalesced {
+		t.Error("expected request of another project not to be coalesced")
+	}
+	body.Project = "mobile-app"
+	body.Coalesce = false
+	if _, other, _ := env.createWithKey("", body); other.Coalesced {
+		t.Error("expected request without coalesce not to be coalesced")
+	}
+
+	env.startConsumer()
+	env.waitForStatus(first.RequestID, domainTranslation.StatusCompleted)
+
+	// Finished requests are not coalesced onto
+	body.Coalesce = true
+	status, third, _ := env.createWithKey("", body)
+	if status != http.StatusCreated || third.RequestID == first.RequestID {
+		t.Errorf("expected new request once the first one finished, got status %d and %+v", status, third)
+	}
+}
+
+func TestReplayOfCoalescedRequest(t *testing.T) {
+	env := newTestEnv(t)
+	body := dto.CreateTranslationRequestRequest{
+		SourceData: map[string]string{"hello": "Hello"},
+		Languages:  []string{"es"},
+		Coalesce:   true,
+	}
+
+	_, first, _ := env.createWithKey("ci-build-1", body)
+	_, coalesced, _ := env.createWithKey("ci-build-2", body)
+	_, replay, replayed := env.createWithKey("ci-build-2", body)
+
+	if coalesced.RequestID != first.RequestID || replay.RequestID != first.RequestID || !replayed {
+		t.Errorf("expected replay of coalesced request to return %s, got %+v", first.RequestID, replay)
+	}
+}
@@ -0,0 +1,133 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	domainTranslation "translation/internal/domain/translation"
+	httpInterface "translation/internal/interfaces/http"
+	"translation/internal/interfaces/http/dto"
+)
+
+// listRequests lists translation requests with given query
+func (e *testEnv) listRequests(query url.Values) dto.ListTranslationRequestsResponse {
+	e.t.Helper()
+
+	var resp dto.ListTranslationRequestsResponse
+	if status := e.do(http.MethodGet, "/api/v1/translations?"+query.Encode(), nil, &resp); status != http.StatusOK {
+		e.t.Fatalf("expected status 200, got %d", status)
+	}
+	return resp
+}
+
+// requestIDs returns IDs of listed requests in order
+func requestIDs(resp dto.ListTranslationRequestsResponse) []string {
+	ids := make([]string, 0, len(resp.Requests))
+	for _, request := range resp.Requests {
+		ids = append(ids, request.RequestID)
+	}
+	return ids
+}
+
+func TestListRequestsFiltersAndPaginates(t *testing.T) {
+	env := newTestEnv(t)
+
+	mobile := env.createPriorityRequest("mobile-app", 2, map[string]string{"hello": "Hello"}, "es")
+	web := env.createPriorityRequest("web-app", 8, map[string]string{"hello": "Hello", "bye": "Bye"}, "es", "fr")
+	cancelled := env.createPriorityRequest("mobile-app", 5, map[string]string{"bye": "Bye"}, "de")
+	env.cancelRequest(cancelled, dto.CancelTranslationRequestRequest{})
+
+	// Newest first by default
+	all := env.listRequests(url.Values{})
+	if got := requestIDs(all); len(got) != 3 || got[0] != cancelled || got[2] != mobile {
+		t.Fatalf("expected requests newest first, got %v", got)
+	}
+	if all.Total != 3 || all.Requests[1].Keys != 2 || all.Requests[1].CreatedBy != httpInterface.APIKeyID(testAPIKey) {
+		t.Errorf("expected summary of request with its keys and API key, got %+v", all.Requests[1])
+	}
+
+	if got := requestIDs(env.listRequests(url.Values{"project": {"mobile-app"}, "sort": {"created_at"}})); len(got) != 2 || got[0] != mobile || got[1] != cancelled {
+		t.Errorf("expected requests of project oldest first, got %v", got)
+	}
+	if got := requestIDs(env.listRequests(url.Values{"status": {"pending,processing"}})); len(got) != 2 {
+		t.Errorf("expected 2 requests in progress, got %v", got)
+	}
+	if got := requestIDs(env.listRequests(url.Values{"sort": {"-priority"}, "limit": {"1"}})); len(got) != 1 || got[0] != web {
+		t.Errorf("expected request with highest priority, got %v", got)
+	}
+	if got := env.listRequests(url.Values{"created_by": {"key_000000000000"}}); got.Total != 0 {
+		t.Errorf("expected no requests of another API key, got %v", requestIDs(got))
+	}
+	future := time.Now().Add(time.Hour).Format(time.RFC3339)
+	if got := env.listRequests(url.Values{"created_from": {future}}); got.Total != 0 {
+		t.Errorf("expected no requests created in the future, got %v", requestIDs(got))
+	}
+
+	page := env.listRequests(url.Values{"offset": {"2"}, "limit": {"2"}})
+	if got := requestIDs(page); len(got) != 1 || got[0] != mobile || page.Total != 3 {
+		t.Errorf("expected last page with the oldest request, got %v of %d", got, page.Total)
+	}
+
+	for _, query := range []url.Values{
+		{"sort": {"name"}},
+		{"status": {"done"}},
+		{"limit": {"0"}},
+		{"offset": {"-1"}},
+		{"created_to": {"yesterday"}},
+	} {
+		if status := env.do(http.MethodGet, "/api/v1/translations?"+query.Encode(), nil, nil); status != http.StatusBadRequest {
+			t.Errorf("expected status 400 for %v, got %d", query, status)
+		}
+	}
+}
+
+func TestArchiveReplacesFinishedRequestsWithSummaries(t *testing.T) {
+	env := newTestEnv(t)
+	env.retention.ArchiveAfter = time.Millisecond
+	env.restart()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := env.runConsumer(ctx)
+	finished := env.createRequest(map[string]string{"hello": "Hello", "bye": "Bye"}, "es")
+	env.waitForStatus(finished, domainTranslation.StatusCompleted)
+	cancel()
+	<-done
+
+	// Without consumer request stays pending
+	pending := env.createRequest(map[string]string{"hello": "Hello"}, "fr")
+	time.Sleep(5 * time.Millisecond)
+
+	archived, err := env.appService.ArchiveRequests(context.Background())
+	if err != nil || archived != 1 {
+		t.Fatalf("expected finished request to be archived, got %d (%v)", archived, err)
+	}
+
+	// Archived request is gone but still listed with its outcome
+	if status := env.do(http.MethodGet, "/api/v1/translations/"+finished, nil, nil); status != http.StatusNotFound {
+		t.Errorf("expected archived request not to be stored, got status %d", status)
+	}
+	list := env.listRequests(url.Values{"status": {"completed"}})
+	if len(list.Requests) != 1 || list.Requests[0].RequestID != finished {
+		t.Fatalf("expected archived request to be listed, got %v", requestIDs(list))
+	}
+	summary := list.Requests[0]
+	if !summary.Archived || summary.ArchivedAt == nil || summary.Progress == nil || summary.Progress.Done != 2 {
+		t.Errorf("expected summary with progress of archived request, got %+v", summary)
+	}
+	if request := env.getRequest(pending); request.Status != string(domainTranslation.StatusPending) {
+		t.Errorf("expected request in progress to be kept, got %s", request.Status)
+	}
+
+	// Summaries past retention are deleted
+	env.retention.ArchiveRetention = time.Millisecond
+	env.restart()
+	if _, err := env.appService.ArchiveRequests(context.Background()); err != nil {
+		t.Fatalf("failed to archive requests: %v", err)
+	}
+	if got := requestIDs(env.listRequests(url.Values{})); len(got) != 1 || got[0] != pending {
+		t.Errorf("expected only request in progress to be listed, got %v", got)
+	}
+}
@@ -1,13 +1,66 @@
 package http
 
 import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
 	"strings"
 
+	domainTranslation "translation/internal/domain/translation"
+
 	"github.com/gofiber/fiber/v2"
 )
 
-// AuthMiddleware creates middleware for API key authentication
-func AuthMiddleware(apiKey string) fiber.Handler {
+// APIKeyAuthenticator resolves secrets of managed API keys
+type APIKeyAuthenticator interface {
+	// Return active API key with given secret
+	AuthenticateAPIKey(ctx context.Context, secret string) (*domainTranslation.APIKey, error)
+}
+
+// UserAuthenticator resolves identity provider tokens of users
+type UserAuthenticator interface {
+	// Return user the token was issued to
+	AuthenticateToken(ctx context.Context, token string) (*domainTranslation.User, error)
+}
+
+// principalLocal is name of request local holding principal the request was authenticated as
+const principalLocal = "principal"
+
+// APIKeyID returns identifier of bootstrap API key that can be stored and shown instead of the key
+func APIKeyID(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return "key_" + hex.EncodeToString(sum[:6])
+}
+
+// principal returns API key or user request was authenticated as, nil for public endpoints
+func principal(c *fiber.Ctx) *domainTranslation.Principal {
+	p, _ := c.Locals(principalLocal).(*domainTranslation.Principal)
+	return p
+}
+
+// principalID returns ID of API key or user request was authenticated as, empty for public endpoints
+func principalID(c *fiber.Ctx) string {
+	if p := principal(c); p != nil {
+		return p.ID
+	}
+	return ""
+}
+
+// changeContext returns context of request attributing changes of translations to its API key or user
+func changeContext(c *fiber.Ctx) context.Context {
+	return domainTranslation.WithChangeUser(c.Context(), principalID(c))
+}
+
+// isJWT reports whether token looks like JSON Web Token rather than API key
+func isJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+// AuthMiddleware creates middleware for authentication with API keys, accepting managed API keys,
+// bootstrap key configured in environment unless it is empty, and user tokens when users is not nil
+func AuthMiddleware(keys APIKeyAuthenticator, users UserAuthenticator, bootstrapKey string) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		// Get Authorization header
 		authHeader := c.Get("Authorization")
@@ -29,13 +82,42 @@ func AuthMiddleware(apiKey string) fiber.Handler {
 			token = authHeader
 		}
 
-		// Validate token
-		if token != apiKey {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Invalid API key",
-			})
+		// Validate token, comparing in constant time so timing doesn't reveal the key
+		var p *domainTranslation.Principal
+		switch {
+		case bootstrapKey != "" && subtle.ConstantTimeCompare([]byte(token), []byte(bootstrapKey)) == 1:
+			p = domainTranslation.BootstrapAPIKey(APIKeyID(token)).Principal()
+		case users != nil && isJWT(token):
+			user, err := users.AuthenticateToken(c.Context(), token)
+			if err != nil {
+				if err.Error() == "invalid token" {
+					return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+						"error": "Invalid token",
+					})
+				}
+				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error": fmt.Sprintf("Failed to authenticate: %v", err),
+				})
+			}
+			p = user.Principal()
+		default:
+			key, err := keys.AuthenticateAPIKey(c.Context(), token)
+			if err != nil {
+				if err.Error() == "invalid api key" {
+					return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+						"error": "Invalid API key",
+					})
+				}
+				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"error": fmt.Sprintf("Failed to authenticate: %v", err),
+				})
+			}
+			p = key.Principal()
 		}
 
+		// Routes authorize actions of the principal, requests record who created them
+		c.Locals(principalLocal, p)
+
 		// Continue to next handler
 		return c.Next()
 	}
@@ -0,0 +1,196 @@
+package http
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"translation/internal/application/translation"
+	"translation/internal/config"
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// OTAHandler represents HTTP handlers for over-the-air translation delivery
+type OTAHandler struct {
+	appService *translation.Service
+	config     config.OTAConfig
+}
+
+// NewOTAHandler creates a new over-the-air delivery handler instance
+func NewOTAHandler(appService *translation.Service, cfg config.OTAConfig) *OTAHandler {
+	return &OTAHandler{
+		appService: appService,
+		config:     cfg,
+	}
+}
+
+// Public reports whether OTA endpoints are served without API key
+func (h *OTAHandler) Public() bool {
+	return h.config.Public
+}
+
+// GetManifest gets manifest of locales available in published release
+// @Summary Get OTA manifest
+// @Description Get locales of a published release with content hashes. Use "latest" as release name for the most recently published release. Supports If-None-Match
+// @Tags ota
+// @Produce json
+// @Param name path string true "Release name or latest"
+// @Param If-None-Match header string false "ETag of cached manifest"
+// @Success 200 {object} dto.OTAManifestResponse
+// @Success 304
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/ota/releases/{name}/manifest [get]
+func (h *OTAHandler) GetManifest(c *fiber.Ctx) error {
+	release, err := h.appService.GetPublishedRelease(c.Context(), c.Params("name"))
+	if err != nil {
+		return releaseError(c, err)
+	}
+
+	manifest := dto.OTAManifestResponse{
+		Release:     release.Name,
+		PublishedAt: release.PublishedAt.Format("2006-01-02T15:04:05Z"),
+		Locales:     []dto.OTALocaleInfo{},
+	}
+
+	for _, locale := range release.Locales() {
+		bundle, body, err := renderBundle(release, locale)
+		if err != nil {
+			return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+				Error: fmt.Sprintf("Failed to render bundle: %v", err),
+			})
+		}
+
+		manifest.Locales = append(manifest.Locales, dto.OTALocaleInfo{
+			Locale:   locale,
+			Hash:     contentHash(body),
+			KeyCount: len(bundle),
+			// Pinned URLs are immutable, so clients can cache bundles forever
+			URL: fmt.Sprintf("/api/v1/ota/releases/%s/locales/%s", release.Name, locale),
+		})
+	}
+
+	body, err := json.Marshal(manifest)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to render manifest: %v", err),
+		})
+	}
+
+	return h.send(c, body)
+}
+
+// GetLocaleBundle gets ARB bundle of one locale from published release
+// @Summary Get OTA locale bundle
+// @Description Get ARB bundle of a locale from a published release. Use "latest" as release name for the most recently published release. Supports If-None-Match, gzip and brotli
+// @Tags ota
+// @Produce json
+// @Param name path string true "Release name or latest"
+// @Param locale path string true "Locale" example(es)
+// @Param If-None-Match header string false "ETag of cached bundle"
+// @Success 200 {object} map[string]string
+// @Success 304
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/ota/releases/{name}/locales/{locale} [get]
+func (h *OTAHandler) GetLocaleBundle(c *fiber.Ctx) error {
+	release, err := h.appService.GetPublishedRelease(c.Context(), c.Params("name"))
+	if err != nil {
+		return releaseError(c, err)
+	}
+
+	if _, exists := release.Bundle(c.Params("locale")); !exists {
+		return c.Status(http.StatusNotFound).JSON(dto.ErrorResponse{
+			Error: "Locale not found",
+		})
+	}
+
+	_, body, err := renderBundle(release, c.Params("locale"))
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to render bundle: %v", err),
+		})
+	}
+
+	return h.send(c, body)
+}
+
+// releaseError writes error response for failed published release lookup
+func releaseError(c *fiber.Ctx, err error) error {
+	if err.Error() == "release not found" {
+		return c.Status(http.StatusNotFound).JSON(dto.ErrorResponse{
+			Error: "Release not found",
+		})
+	}
+	return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error: fmt.Sprintf("Failed to get release: %v", err),
+	})
+}
+
+// send writes JSON body with strong ETag and cache headers, replying 304 when client copy is current
+func (h *OTAHandler) send(c *fiber.Ctx, body []byte) error {
+	etag := `"` + contentHash(body) + `"`
+
+	c.Set(fiber.HeaderETag, etag)
+	// Shared caches mustn't serve bundles fetched with credentials to others
+	visibility := "private"
+	if h.config.Public {
+		visibility = "public"
+	}
+	if c.Params("name") == domainTranslation.LatestRelease {
+		// Alias moves on every publish, so clients revalidate it regularly
+		c.Set(fiber.HeaderCacheControl, fmt.Sprintf("%s, max-age=%d", visibility, h.config.CacheMaxAge))
+	} else {
+		c.Set(fiber.HeaderCacheControl, visibility+", max-age=31536000, immutable")
+	}
+
+	if etagMatches(c.Get(fiber.HeaderIfNoneMatch), etag) {
+		return c.SendStatus(http.StatusNotModified)
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+	return c.Send(body)
+}
+
+// renderBundle renders locale bundle of release in ARB format
+func renderBundle(release *domainTranslation.Release, locale string) (map[string]string, []byte, error) {
+	bundle, _ := release.Bundle(locale)
+
+	arb := make(map[string]string, len(bundle)+1)
+	for key, value := range bundle {
+		arb[key] = value
+	}
+	arb["@@locale"] = locale
+
+	// Map keys are marshalled in sorted order, so equal bundles always hash equally
+	body, err := json.Marshal(arb)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return bundle, body, nil
+}
+
+// contentHash returns hex-encoded SHA-256 of content
+func contentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// etagMatches checks If-None-Match header against ETag using weak comparison
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
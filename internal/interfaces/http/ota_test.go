@@ -0,0 +1,229 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"translation/internal/interfaces/http/dto"
+)
+
+// otaGet performs unauthenticated OTA request with optional headers
+func (e *testEnv) otaGet(path string, headers map[string]string) *http.Response {
+	e.t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := e.app.Test(req, -1)
+	if err != nil {
+		e.t.Fatalf("request %s failed: %v", path, err)
+	}
+	e.t.Cleanup(func() { resp.Body.Close() })
+
+	return resp
+}
+
+// publishRelease creates and publishes release with current translations
+func (e *testEnv) publishRelease(name string) {
+	e.t.Helper()
+
+	e.createRelease(name)
+	if status := e.do(http.MethodPost, "/api/v1/releases/"+name+"/publish", nil, nil); status != http.StatusOK {
+		e.t.Fatalf("expected status 200, got %d", status)
+	}
+}
+
+// decodeBody decodes JSON response body into out
+func decodeBody(t *testing.T, resp *http.Response, out any) {
+	t.Helper()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read response: %v", err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		t.Fatalf("failed to decode response %q: %v", data, err)
+	}
+}
+
+func TestOTAServesOnlyPublishedReleases(t *testing.T) {
+	env := newTestEnv(t)
+
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello"},
+		"es": {"hello": "Hola"},
+	})
+	env.createRelease("v1.0.0")
+
+	if resp := env.otaGet("/api/v1/ota/releases/v1.0.0/manifest", nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected unpublished release to be hidden, got %d", resp.StatusCode)
+	}
+	if resp := env.otaGet("/api/v1/ota/releases/latest/manifest", nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected no latest release before publishing, got %d", resp.StatusCode)
+	}
+
+	if status := env.do(http.MethodPost, "/api/v1/releases/v1.0.0/publish", nil, nil); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+
+	resp := env.otaGet("/api/v1/ota/releases/v1.0.0/manifest", nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+
+	var manifest dto.OTAManifestResponse
+	decodeBody(t, resp, &manifest)
+	if manifest.Release != "v1.0.0" || len(manifest.Locales) != 2 {
+		t.Fatalf("unexpected manifest: %+v", manifest)
+	}
+
+	for _, locale := range manifest.Locales {
+		bundleResp := env.otaGet(locale.URL, nil)
+		if bundleResp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status 200 for %s, got %d", locale.URL, bundleResp.StatusCode)
+		}
+		if etag := bundleResp.Header.Get("ETag"); etag != `"`+locale.Hash+`"` {
+			t.Errorf("expected ETag of %s to match manifest hash, got %s", locale.Locale, etag)
+		}
+
+		var bundle map[string]string
+		decodeBody(t, bundleResp, &bundle)
+		if bundle["@@locale"] != locale.Locale {
+			t.Errorf("expected ARB locale %s, got %q", locale.Locale, bundle["@@locale"])
+		}
+	}
+
+	if resp := env.otaGet("/api/v1/ota/releases/v1.0.0/locales/ja", nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status 404 for unknown locale, got %d", resp.StatusCode)
+	}
+}
+
+func TestOTAConditionalRequestsAndCaching(t *testing.T) {
+	env := newTestEnv(t)
+
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello"},
+		"es": {"hello": "Hola"},
+	})
+	env.publishRelease("v1.0.0")
+
+	resp := env.otaGet("/api/v1/ota/releases/v1.0.0/locales/es", nil)
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("expected ETag header")
+	}
+	if cc := resp.Header.Get("Cache-Control"); cc != "public, max-age=31536000, immutable" {
+		t.Errorf("expected pinned release to be immutable, got %q", cc)
+	}
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        int
+	}{
+		{"matching ETag", etag, http.StatusNotModified},
+		{"weak matching ETag", "W/" + etag, http.StatusNotModified},
+		{"one of several ETags", `"stale", ` + etag, http.StatusNotModified},
+		{"wildcard", "*", http.StatusNotModified},
+		{"stale ETag", `"stale"`, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := env.otaGet("/api/v1/ota/releases/v1.0.0/locales/es", map[string]string{"If-None-Match": tt.ifNoneMatch})
+			if resp.StatusCode != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, resp.StatusCode)
+			}
+			if resp.Header.Get("ETag") != etag {
+				t.Errorf("expected ETag on every response, got %q", resp.Header.Get("ETag"))
+			}
+		})
+	}
+
+	latest := env.otaGet("/api/v1/ota/releases/latest/locales/es", nil)
+	if cc := latest.Header.Get("Cache-Control"); cc != "public, max-age=60" {
+		t.Errorf("expected latest alias to use configured max-age, got %q", cc)
+	}
+	if latest.Header.Get("ETag") != etag {
+		t.Error("expected latest alias to serve the same content as pinned release")
+	}
+}
+
+func TestOTALatestFollowsPublishing(t *testing.T) {
+	env := newTestEnv(t)
+
+	env.cacheTranslations(map[string]map[string]string{"en": {"hello": "Hello"}, "es": {"hello": "Hola"}})
+	env.publishRelease("v1.0.0")
+	first := env.otaGet("/api/v1/ota/releases/latest/manifest", nil).Header.Get("ETag")
+
+	env.cacheTranslations(map[string]map[string]string{"en": {"hello": "Hello"}, "es": {"hello": "Hola Mundo"}})
+	env.createRelease("v1.1.0")
+
+	resp := env.otaGet("/api/v1/ota/releases/latest/manifest", map[string]string{"If-None-Match": first})
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("expected unpublished release not to change latest, got %d", resp.StatusCode)
+	}
+
+	if status := env.do(http.MethodPost, "/api/v1/releases/v1.1.0/publish", nil, nil); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+
+	resp = env.otaGet("/api/v1/ota/releases/latest/manifest", map[string]string{"If-None-Match": first})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected new manifest after publishing, got %d", resp.StatusCode)
+	}
+
+	var manifest dto.OTAManifestResponse
+	decodeBody(t, resp, &manifest)
+	if manifest.Release != "v1.1.0" {
+		t.Errorf("expected latest to resolve to v1.1.0, got %s", manifest.Release)
+	}
+}
+
+func TestOTACompression(t *testing.T) {
+	env := newTestEnv(t)
+
+	// Compression only kicks in for bodies of reasonable size
+	en, es := make(map[string]string), make(map[string]string)
+	for i := 0; i < 50; i++ {
+		key := fmt.Sprintf("key%d", i)
+		en[key] = fmt.Sprintf("English text number %d", i)
+		es[key] = fmt.Sprintf("Texto en español número %d", i)
+	}
+	env.cacheTranslations(map[string]map[string]string{"en": en, "es": es})
+	env.publishRelease("v1.0.0")
+
+	for _, encoding := range []string{"gzip", "br"} {
+		resp := env.otaGet("/api/v1/ota/releases/v1.0.0/locales/es", map[string]string{"Accept-Encoding": encoding})
+		if got := resp.Header.Get("Content-Encoding"); got != encoding {
+			t.Errorf("expected %s encoding, got %q", encoding, got)
+		}
+	}
+}
+
+func TestOTACanRequireAuthorization(t *testing.T) {
+	env := newTestEnv(t)
+	env.otaConfig.Public = false
+	env.restart()
+
+	env.cacheTranslations(map[string]map[string]string{"en": {"hello": "Hello"}})
+	env.publishRelease("v1.0.0")
+
+	if resp := env.otaGet("/api/v1/ota/releases/v1.0.0/manifest", nil); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected status 401, got %d", resp.StatusCode)
+	}
+
+	resp := env.otaGet("/api/v1/ota/releases/v1.0.0/manifest", map[string]string{"Authorization": "Bearer " + testAPIKey})
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200 with API key, got %d", resp.StatusCode)
+	}
+	if cc := resp.Header.Get("Cache-Control"); cc != "private, max-age=31536000, immutable" {
+		t.Errorf("expected authorized bundle to be cached privately, got %q", cc)
+	}
+}
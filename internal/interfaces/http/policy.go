@@ -0,0 +1,216 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// resource describes what request operates on, for authorization
+type resource struct {
+	project string
+	// kind names resource in responses
+	kind string
+	// all is set for listings across projects, handlers limit them to projects returned by visibleProjects
+	all bool
+	// byID is set for stored resources, which are reported missing to principals without role in their project
+	byID bool
+	// missing is set when stored resource doesn't exist, handlers report it
+	missing bool
+}
+
+// projectResolver finds resource request operates on
+type projectResolver func(c *fiber.Ctx) (resource, error)
+
+// Authorize creates middleware allowing request only when its principal may perform action on resource
+// found by resolve. Resolve is nil for actions on data shared by all projects. Public endpoints, which
+// have no principal, aren't authorized.
+func Authorize(action domainTranslation.Action, resolve projectResolver) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		p := principal(c)
+		if p == nil {
+			return c.Next()
+		}
+
+		// Audit log records the action, whether it is allowed or not
+		c.Locals(actionLocal, action)
+
+		if !p.CanAnywhere(action) {
+			return forbidden(c, action)
+		}
+
+		// Principals allowed in all projects don't need the resource looked up
+		if resolve == nil || action.Scope() != domainTranslation.ScopeProject || p.ProjectsWhere(action) == nil {
+			return c.Next()
+		}
+
+		res, err := resolve(c)
+		if err != nil {
+			return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+				Error: fmt.Sprintf("Failed to authorize request: %v", err),
+			})
+		}
+		if res.all || res.missing || p.Can(action, res.project) {
+			return c.Next()
+		}
+
+		if res.byID && !p.HasRoleIn(res.project) {
+			return c.Status(http.StatusNotFound).JSON(dto.ErrorResponse{
+				Error: res.kind + " not found",
+			})
+		}
+		return forbidden(c, action)
+	}
+}
+
+// forbidden responds that principal may not perform action
+func forbidden(c *fiber.Ctx, action domainTranslation.Action) error {
+	return c.Status(http.StatusForbidden).JSON(dto.ErrorResponse{
+		Error: fmt.Sprintf("Not allowed to %s", action),
+	})
+}
+
+// visibleProjects returns projects listings are limited to for action, nil when they aren't limited
+func visibleProjects(c *fiber.Ctx, action domainTranslation.Action) []string {
+	if p := principal(c); p != nil {
+		return p.ProjectsWhere(action)
+	}
+	return nil
+}
+
+// allowedIn reports whether request may perform action in project, public endpoints may in all projects
+func allowedIn(c *fiber.Ctx, action domainTranslation.Action, project string) bool {
+	p := principal(c)
+	return p == nil || p.Can(action, project)
+}
+
+// projectFromBody resolves project given in JSON body, requests without project belong to the default project
+func projectFromBody(c *fiber.Ctx) (resource, error) {
+	var body struct {
+		Project string `json:"project"`
+	}
+	// Invalid bodies are rejected by handlers
+	json.Unmarshal(c.Body(), &body)
+
+	return resource{project: body.Project}, nil
+}
+
+// projectFromQuery resolves project given in query, listing all projects when it is omitted
+func projectFromQuery(c *fiber.Ctx) (resource, error) {
+	project := c.Query("project")
+	return resource{project: project, all: project == ""}, nil
+}
+
+// projectParam resolves project given in query, the default project when it is omitted
+func projectParam(c *fiber.Ctx) (resource, error) {
+	return resource{project: c.Query("project")}, nil
+}
+
+// requestProject resolves project of translation request in id parameter
+func (h *Handler) requestProject(c *fiber.Ctx) (resource, error) {
+	return h.requestResource(c, c.Params("id"))
+}
+
+// requestResource resolves project of translation request with ID
+func (h *Handler) requestResource(c *fiber.Ctx, id string) (resource, error) {
+	requestID, err := uuid.Parse(id)
+	if err != nil {
+		return resource{missing: true}, nil
+	}
+
+	request, err := h.appService.GetTranslationRequest(c.Context(), requestID)
+	if err != nil {
+		return resource{missing: true}, nil
+	}
+
+	return resource{project: request.Project, kind: "Translation request", byID: true}, nil
+}
+
+// webhookResource resolves project of webhook with ID
+func (h *Handler) webhookResource(c *fiber.Ctx, id string) (resource, error) {
+	webhookID, err := uuid.Parse(id)
+	if err != nil {
+		return resource{missing: true}, nil
+	}
+
+	webhook, err := h.appService.GetWebhook(c.Context(), webhookID)
+	if err != nil {
+		return resource{missing: true}, nil
+	}
+
+	return resource{project: webhook.Project, kind: "Webhook", byID: true}, nil
+}
+
+// webhookProject resolves project of webhook in id parameter
+func (h *Handler) webhookProject(c *fiber.Ctx) (resource, error) {
+	return h.webhookResource(c, c.Params("id"))
+}
+
+// deliveryProject resolves project of webhook delivery in id parameter: project of its webhook, or of its
+// request for deliveries to callback URLs
+func (h *Handler) deliveryProject(c *fiber.Ctx) (resource, error) {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return resource{missing: true}, nil
+	}
+
+	delivery, err := h.appService.GetDelivery(c.Context(), id)
+	if err != nil {
+		return resource{missing: true}, nil
+	}
+
+	res := resource{missing: true}
+	if delivery.WebhookID != nil {
+		if res, err = h.webhookResource(c, delivery.WebhookID.String()); err != nil {
+			return res, err
+		}
+	}
+	if res.missing {
+		if res, err = h.requestResource(c, delivery.RequestID.String()); err != nil {
+			return res, err
+		}
+	}
+
+	// Delivery that outlived its webhook and request is only seen by principals allowed in all projects
+	return resource{project: res.project, kind: "Delivery", byID: true}, nil
+}
+
+// deliveriesProject resolves project of deliveries listed by request_id or webhook_id query. Deliveries
+// of requests and webhooks that no longer exist are only listed to principals allowed in all projects.
+func (h *Handler) deliveriesProject(c *fiber.Ctx) (resource, error) {
+	var res resource
+	var err error
+	switch id := c.Query("request_id"); {
+	case id != "":
+		res, err = h.requestResource(c, id)
+	case c.Query("webhook_id") != "":
+		res, err = h.webhookResource(c, c.Query("webhook_id"))
+	default:
+		// Handler rejects listing without filter
+		return resource{missing: true}, nil
+	}
+	if err != nil || !res.missing {
+		return res, err
+	}
+
+	if !validIDs(c.Query("request_id"), c.Query("webhook_id")) {
+		return res, nil
+	}
+	return resource{kind: "Deliveries", byID: true}, nil
+}
+
+// validIDs reports whether all non-empty ids are UUIDs
+func validIDs(ids ...string) bool {
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); id != "" && err != nil {
+			return false
+		}
+	}
+	return true
+}
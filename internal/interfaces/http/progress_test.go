@@ -0,0 +1,103 @@
+package http_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+)
+
+// resultOf finds outcome of key language in response, nil if absent
+func resultOf(resp dto.GetTranslationRequestResponse, key, language string) *dto.KeyResultInfo {
+	for i := range resp.Results {
+		if resp.Results[i].Key == key && resp.Results[i].Language == language {
+			return &resp.Results[i]
+		}
+	}
+	return nil
+}
+
+func TestProgressCountsOutcomesPerLanguage(t *testing.T) {
+	env := newTestEnv(t)
+	env.translator.FailLanguage("de", errors.New("provider unavailable"))
+	env.startConsumer()
+
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello"},
+		"es": {"hello": "Hola"},
+		"de": {"hello": "Hallo"},
+	})
+
+	id := env.createRequest(map[string]string{
+		"hello":    "Hello",
+		"welcome":  "Welcome",
+		"@@locale": "en",
+	}, "es", "de")
+	resp := env.waitForStatus(id, domainTranslation.StatusPartiallyCompleted)
+
+	want := dto.LanguageProgressInfo{Total: 4, Done: 1, Failed: 1, Skipped: 2}
+	if resp.Progress == nil || resp.Progress.LanguageProgressInfo != want {
+		t.Fatalf("unexpected overall progress: %+v", resp.Progress)
+	}
+	if es := resp.Progress.Languages["es"]; es != (dto.LanguageProgressInfo{Total: 2, Done: 1, Skipped: 1}) {
+		t.Errorf("unexpected Spanish progress: %+v", es)
+	}
+	if de := resp.Progress.Languages["de"]; de != (dto.LanguageProgressInfo{Total: 2, Failed: 1, Skipped: 1}) {
+		t.Errorf("unexpected German progress: %+v", de)
+	}
+
+	if result := resultOf(resp, "hello", "es"); result == nil || result.Outcome != string(domainTranslation.KeyOutcomeCached) {
+		t.Errorf("expected cached translation to be reused, got %+v", result)
+	}
+	if result := resultOf(resp, "welcome", "es"); result == nil || result.Outcome != string(domainTranslation.KeyOutcomeTranslated) {
+		t.Errorf("expected key to be translated, got %+v", result)
+	}
+	if result := resultOf(resp, "welcome", "de"); result == nil || result.Outcome != string(domainTranslation.KeyOutcomeFailed) ||
+		result.Error != "provider unavailable" {
+		t.Errorf("expected failure with reason, got %+v", result)
+	}
+	if len(resp.Results) != 4 {
+		t.Errorf("expected one result per key language, got %+v", resp.Results)
+	}
+	if resp.TranslatedData["es"]["welcome"] == "" {
+		t.Error("expected translated data of partially completed request")
+	}
+
+	var failed dto.GetTranslationRequestResponse
+	env.do(http.MethodGet, "/api/v1/translations/"+id+"?outcome=failed", nil, &failed)
+	if len(failed.Results) != 1 || failed.Results[0].Key != "welcome" {
+		t.Errorf("expected only failed results, got %+v", failed.Results)
+	}
+}
+
+func TestRequestWithAllKeysCachedIsCompleted(t *testing.T) {
+	env := newTestEnv(t)
+	env.startConsumer()
+
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello"},
+		"es": {"hello": "Hola"},
+	})
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	resp := env.waitForStatus(id, domainTranslation.StatusCompleted)
+
+	if resp.Progress == nil || resp.Progress.Skipped != 1 || resp.Progress.Pending != 0 {
+		t.Errorf("expected key to be counted as reused from cache, got %+v", resp.Progress)
+	}
+}
+
+func TestRequestWithAllKeysFailedIsFailed(t *testing.T) {
+	env := newTestEnv(t)
+	env.translator.FailLanguage("es", errors.New("provider unavailable"))
+	env.startConsumer()
+
+	id := env.createRequest(map[string]string{"hello": "Hello", "bye": "Bye"}, "es")
+	resp := env.waitForStatus(id, domainTranslation.StatusFailed)
+
+	if resp.Progress == nil || resp.Progress.Failed != 2 {
+		t.Errorf("expected both keys to be failed, got %+v", resp.Progress)
+	}
+}
@@ -0,0 +1,40 @@
+package http_test
+
+import (
+	"net/http"
+	"testing"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+)
+
+// getQueueInfo returns state of task queue
+func (e *testEnv) getQueueInfo() dto.GetQueueInfoResponse {
+	e.t.Helper()
+
+	var resp dto.GetQueueInfoResponse
+	if status := e.do(http.MethodGet, "/api/v1/admin/queue", nil, &resp); status != http.StatusOK {
+		e.t.Fatalf("expected status 200, got %d", status)
+	}
+	return resp
+}
+
+func TestQueueInfoCountsWaitingTasks(t *testing.T) {
+	env := newTestEnv(t)
+
+	first := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	second := env.createRequest(map[string]string{"hello": "Hello"}, "fr")
+
+	if queued := env.getQueueInfo().Queued; queued != 2 {
+		t.Fatalf("expected 2 queued tasks, got %d", queued)
+	}
+
+	// Request tasks are consumed and replaced by chunk tasks that are processed too
+	env.startConsumer()
+	env.waitForStatus(first, domainTranslation.StatusCompleted)
+	env.waitForStatus(second, domainTranslation.StatusCompleted)
+
+	if queued := env.getQueueInfo().Queued; queued != 0 {
+		t.Errorf("expected empty queue, got %d queued tasks", queued)
+	}
+}
@@ -0,0 +1,123 @@
+package http
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"translation/internal/application/translation"
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// secondsUntil returns whole seconds until t, rounded up so clients don't retry early
+func secondsUntil(t time.Time) int {
+	return int((time.Until(t) + time.Second - 1) / time.Second)
+}
+
+// RateLimit creates middleware limiting API requests of each API key or user, reporting the limit in
+// RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers. Public endpoints aren't limited.
+func RateLimit(appService *translation.Service) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		p := principal(c)
+		if p == nil {
+			return c.Next()
+		}
+
+		usage, allowed, err := appService.AllowRequest(c.Context(), p.ID)
+		if err != nil {
+			// Requests aren't refused while usage can't be counted
+			log.Printf("Failed to check rate limit of %s: %v", p.ID, err)
+			return c.Next()
+		}
+		if usage == nil {
+			return c.Next()
+		}
+
+		c.Set("RateLimit-Limit", strconv.FormatInt(usage.Limit, 10))
+		c.Set("RateLimit-Remaining", strconv.FormatInt(usage.Remaining(), 10))
+		c.Set("RateLimit-Reset", strconv.Itoa(secondsUntil(usage.ResetAt)))
+
+		if !allowed {
+			c.Set("Retry-After", strconv.Itoa(secondsUntil(usage.ResetAt)))
+			return c.Status(http.StatusTooManyRequests).JSON(dto.ErrorResponse{
+				Error: fmt.Sprintf("Rate limit of %d requests per minute exceeded", usage.Limit),
+			})
+		}
+
+		return c.Next()
+	}
+}
+
+// consumeQuotas charges number of translations and characters to quotas of project. Reports false after
+// responding with error when a quota is exceeded or can't be checked.
+func (h *Handler) consumeQuotas(c *fiber.Ctx, project string, keys int64, characters int64) (bool, error) {
+	exceeded, err := h.appService.ConsumeQuotas(c.Context(), project, keys, characters)
+	if err != nil {
+		return false, c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to check quotas: %v", err),
+		})
+	}
+	if exceeded == nil {
+		return true, nil
+	}
+
+	c.Set("Retry-After", strconv.Itoa(secondsUntil(exceeded.ResetAt)))
+	return false, c.Status(http.StatusTooManyRequests).JSON(dto.ErrorResponse{
+		Error: fmt.Sprintf("Project quota of %d %s exceeded, %d used", exceeded.Limit, quotaUnits[exceeded.Name], exceeded.Used),
+	})
+}
+
+// quotaUnits describes what quotas limit in error messages
+var quotaUnits = map[domainTranslation.QuotaName]string{
+	domainTranslation.QuotaKeysPerDay:         "keys per day",
+	domainTranslation.QuotaCharactersPerMonth: "characters per month",
+}
+
+// GetUsage gets usage of rate limit and project quotas
+// @Summary Get usage
+// @Description Get usage of the caller's rate limit of requests per minute, unless it is unlimited, and of the project's quotas of keys (times languages) per day and characters (times languages) per month. Windows are UTC minutes, days and months.
+// @Tags usage
+// @Produce json
+// @Security ApiKeyAuth
+// @Param project query string false "Project, the default project when omitted"
+// @Success 200 {object} dto.GetUsageResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/usage [get]
+func (h *Handler) GetUsage(c *fiber.Ctx) error {
+	project := c.Query("project")
+
+	usages, err := h.appService.GetUsage(c.Context(), principalID(c), project)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to get usage: %v", err),
+		})
+	}
+
+	infos := make([]dto.UsageInfo, 0, len(usages))
+	for _, usage := range usages {
+		info := dto.UsageInfo{
+			Name:    string(usage.Name),
+			Used:    usage.Used,
+			ResetAt: usage.ResetAt.Format("2006-01-02T15:04:05Z"),
+		}
+		if usage.Limit > 0 {
+			limit, remaining := usage.Limit, usage.Remaining()
+			info.Limit = &limit
+			info.Remaining = &remaining
+		}
+		infos = append(infos, info)
+	}
+
+	return c.JSON(dto.GetUsageResponse{
+		Project: project,
+		Usage:   infos,
+	})
+}
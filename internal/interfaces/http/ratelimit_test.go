@@ -0,0 +1,193 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+)
+
+// doWithHeaders performs authorized request against the API and returns response with body still unread
+func (e *testEnv) doWithHeaders(method, path string, body any) *http.Response {
+	e.t.Helper()
+
+	data, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, path, bytes.NewReader(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+testAPIKey)
+
+	resp, err := e.app.Test(req, -1)
+	if err != nil {
+		e.t.Fatalf("request %s %s failed: %v", method, path, err)
+	}
+	e.t.Cleanup(func() { resp.Body.Close() })
+
+	return resp
+}
+
+// getUsage fetches usage of project
+func (e *testEnv) getUsage(project string) map[string]dto.UsageInfo {
+	e.t.Helper()
+
+	var resp dto.GetUsageResponse
+	if status := e.do(http.MethodGet, "/api/v1/usage?project="+project, nil, &resp); status != http.StatusOK {
+		e.t.Fatalf("expected status 200, got %d", status)
+	}
+
+	usage := make(map[string]dto.UsageInfo, len(resp.Usage))
+	for _, info := range resp.Usage {
+		usage[info.Name] = info
+	}
+	return usage
+}
+
+func TestRequestRateLimit(t *testing.T) {
+	env := newTestEnv(t)
+	env.rateLimits.RequestsPerMinute = 3
+	env.restart()
+
+	// Limits apply to each API key on its own
+	key := env.createAPIKey(dto.CreateAPIKeyRequest{Name: "other", Permissions: []string{"read"}})
+
+	for i := 2; i <= 3; i++ {
+		resp := env.doWithHeaders(http.MethodGet, "/api/v1/translations", nil)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected request %d to be allowed, got status %d", i, resp.StatusCode)
+		}
+		if limit := resp.Header.Get("RateLimit-Limit"); limit != "3" {
+			t.Errorf("expected RateLimit-Limit 3, got %q", limit)
+		}
+		if remaining := resp.Header.Get("RateLimit-Remaining"); remaining != strconv.Itoa(3-i) {
+			t.Errorf("expected RateLimit-Remaining %d, got %q", 3-i, remaining)
+		}
+		if reset, err := strconv.Atoi(resp.Header.Get("RateLimit-Reset")); err != nil || reset < 1 || reset > 60 {
+			t.Errorf("expected RateLimit-Reset within a minute, got %q", resp.Header.Get("RateLimit-Reset"))
+		}
+	}
+
+	resp := env.doWithHeaders(http.MethodGet, "/api/v1/translations", nil)
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429, got %d", resp.StatusCode)
+	}
+	if retry, err := strconv.Atoi(resp.Header.Get("Retry-After")); err != nil || retry < 1 || retry > 60 {
+		t.Errorf("expected Retry-After within a minute, got %q", resp.Header.Get("Retry-After"))
+	}
+
+	// Other keys aren't affected and public endpoints aren't limited
+	if status := env.doAs(key.Key, http.MethodGet, "/api/v1/translations", nil, nil); status != http.StatusOK {
+		t.Errorf("expected another key to be allowed, got status %d", status)
+	}
+	if status := env.do(http.MethodGet, "/api/v1/health", nil, nil); status != http.StatusOK {
+		t.Errorf("expected health check not to be limited, got status %d", status)
+	}
+}
+
+func TestProjectQuotas(t *testing.T) {
+	env := newTestEnv(t)
+	env.rateLimits.KeysPerDay = 4
+	env.rateLimits.CharactersPerMonth = 100
+	env.rateLimits.ProjectWeights = map[string]int{"big-app": 10}
+	env.restart()
+
+	// Two keys in two languages, metadata keys aren't charged
+	source := map[string]string{"hello": "Hello", "bye": "Bye", "@@locale": "en"}
+	env.createPriorityRequest("mobile-app", 0, source, "es", "fr")
+
+	usage := env.getUsage("mobile-app")
+	if keys := usage["keys_per_day"]; keys.Used != 4 || keys.Limit == nil || *keys.Limit != 4 || *keys.Remaining != 0 {
+		t.Errorf("expected 4 of 4 keys used, got %+v", keys)
+	}
+	if chars := usage["characters_per_month"]; chars.Used != 16 || *chars.Remaining != 84 {
+		t.Errorf("expected 16 characters used, got %+v", chars)
+	}
+	if _, ok := usage["requests_per_minute"]; ok {
+		t.Errorf("expected unlimited rate limit to be left out, got %+v", usage)
+	}
+
+	body := dto.CreateTranslationRequestRequest{SourceData: map[string]string{"yes": "Yes"}, Languages: []string{"es"}, Project: "mobile-app"}
+	resp := env.doWithHeaders(http.MethodPost, "/api/v1/translations", body)
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected request over quota to be refused, got status %d", resp.StatusCode)
+	}
+	if retry, err := strconv.Atoi(resp.Header.Get("Retry-After")); err != nil || retry < 1 || retry > 24*60*60 {
+		t.Errorf("expected Retry-After within a day, got %q", resp.Header.Get("Retry-After"))
+	}
+	sync := dto.TranslateRequest{SourceData: body.SourceData, Languages: body.Languages, Project: "mobile-app"}
+	if status := env.do(http.MethodPost, "/api/v1/translate", sync, nil); status != http.StatusTooManyRequests {
+		t.Errorf("expected synchronous translation over quota to be refused, got status %d", status)
+	}
+
+	// Quotas are per project and multiplied by project weights
+	body.Project = "web-app"
+	env.createPriorityRequest("web-app", 0, body.SourceData, "es")
+	long := map[string]string{"text": string(bytes.Repeat([]byte("a"), 101))}
+	if status := env.do(http.MethodPost, "/api/v1/translations", dto.CreateTranslationRequestRequest{SourceData: long, Languages: []string{"es"}, Project: "web-app"}, nil); status != http.StatusTooManyRequests {
+		t.Errorf("expected request over characters quota to be refused, got status %d", status)
+	}
+	env.createPriorityRequest("big-app", 0, long, "es")
+
+	// Requests that aren't created, like idempotent replays, aren't charged
+	replay := dto.CreateTranslationRequestRequest{SourceData: map[string]string{"ok": "OK"}, Languages: []string{"es"}, Project: "web-app"}
+	for i := 0; i < 2; i++ {
+		if status, _, _ := env.createWithKey("same", replay); status != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d", status)
+		}
+	}
+	if keys := env.getUsage("web-app")["keys_per_day"]; keys.Used != 2 {
+		t.Errorf("expected only created requests to be charged, got %+v", keys)
+	}
+}
+
+func TestRetryChargesProjectQuotas(t *testing.T) {
+	env := newTestEnv(t)
+	env.rateLimits.KeysPerDay = 5
+	env.restart()
+	env.translator.FailLanguage("de", errors.New("rate limit exceeded"))
+	env.startConsumer()
+
+	id := env.createPriorityRequest("mobile-app", 0, map[string]string{"hello": "Hello", "bye": "Bye"}, "es", "de")
+	env.waitForStatus(id, domainTranslation.StatusPartiallyCompleted)
+	env.translator.FailLanguage("de", nil)
+
+	// Retrying the two failed key languages needs more than the one remaining
+	resp := env.doWithHeaders(http.MethodPost, "/api/v1/translations/"+id+"/retry", dto.RetryTranslationRequestRequest{})
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected retry over quota to be refused, got status %d", resp.StatusCode)
+	}
+	if resp.Header.Get("Retry-After") == "" {
+		t.Errorf("expected Retry-After to be set")
+	}
+	if keys := env.getUsage("mobile-app")["keys_per_day"]; keys.Used != 4 {
+		t.Errorf("expected refused retry not to be charged, got %+v", keys)
+	}
+	if status := env.getRequest(id).Status; status != string(domainTranslation.StatusPartiallyCompleted) {
+		t.Errorf("expected refused retry to leave request %s, got %s", domainTranslation.StatusPartiallyCompleted, status)
+	}
+
+	// Usage is kept across restarts
+	env.rateLimits.KeysPerDay = 6
+	env.restart()
+	env.startConsumer()
+
+	if status, _ := env.retryRequest(id, dto.RetryTranslationRequestRequest{}); status != http.StatusAccepted {
+		t.Fatalf("expected status 202, got %d", status)
+	}
+	env.waitForStatus(id, domainTranslation.StatusCompleted)
+	if keys := env.getUsage("mobile-app")["keys_per_day"]; keys.Used != 6 {
+		t.Errorf("expected retried key languages to be charged, got %+v", keys)
+	}
+
+	// Invalid retries aren't charged
+	if status, _ := env.retryRequest(id, dto.RetryTranslationRequestRequest{Languages: []string{"fr"}}); status != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", status)
+	}
+	if keys := env.getUsage("mobile-app")["keys_per_day"]; keys.Used != 6 {
+		t.Errorf("expected invalid retry not to be charged, got %+v", keys)
+	}
+}
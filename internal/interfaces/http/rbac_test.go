@@ -0,0 +1,122 @@
+package http_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"translation/internal/interfaces/http/dto"
+)
+
+func TestProjectRoles(t *testing.T) {
+	env := newTestEnv(t)
+	idp := env.useIdentityProvider()
+
+	mobile := env.createPriorityRequest("mobile-app", 0, map[string]string{"hello": "Hello"}, "es")
+	web := env.createPriorityRequest("web-app", 0, map[string]string{"hello": "Hello"}, "es")
+	mobileHook := env.createWebhook("mobile-app", "https://example.com/mobile")
+	webHook := env.createWebhook("web-app", "https://example.com/web")
+
+	cache := dto.CacheTranslationsRequest{Translations: map[string]map[string]string{"en": {"bye": "Bye"}, "es": {"bye": "Adiós"}}}
+	env.do(http.MethodPost, "/api/v1/translations/cache", cache, nil)
+
+	// Translators request translations, reviewers also correct them
+	translator := idp.sign("RS256", idp.claims("tom", map[string]any{"roles": []string{"translator"}}))
+	reviewer := idp.sign("RS256", idp.claims("rita", map[string]any{"roles": []string{"reviewer"}}))
+	body := dto.CreateTranslationRequestRequest{SourceData: map[string]string{"yes": "Yes"}, Languages: []string{"fr"}, Project: "web-app"}
+	if status := env.doAs(translator, http.MethodPost, "/api/v1/translations", body, nil); status != http.StatusCreated {
+		t.Errorf("expected translator to create request, got status %d", status)
+	}
+	if status := env.doAs(translator, http.MethodPost, "/api/v1/translations/cache", cache, nil); status != http.StatusForbidden {
+		t.Errorf("expected translator not to change translations, got status %d", status)
+	}
+	if status := env.doAs(reviewer, http.MethodPost, "/api/v1/translations/cache", cache, nil); status != http.StatusOK {
+		t.Errorf("expected reviewer to change translations, got status %d", status)
+	}
+	if status := env.doAs(reviewer, http.MethodDelete, "/api/v1/translations/bye", nil, nil); status != http.StatusForbidden {
+		t.Errorf("expected reviewer not to delete keys, got status %d", status)
+	}
+	if status := env.doAs(reviewer, http.MethodPost, "/api/v1/releases", dto.CreateReleaseRequest{Name: "v1"}, nil); status != http.StatusForbidden {
+		t.Errorf("expected reviewer not to create releases, got status %d", status)
+	}
+
+	// Developers of a project manage its webhooks, but can't delete keys shared by all projects
+	dev := idp.sign("RS256", idp.claims("dana", map[string]any{
+		"projects": map[string]any{"mobile-app": []string{"developer"}},
+	}))
+	var hooks dto.GetWebhooksResponse
+	if status := env.doAs(dev, http.MethodGet, "/api/v1/webhooks", nil, &hooks); status != http.StatusOK {
+		t.Fatalf("expected developer to list webhooks, got status %d", status)
+	}
+	if hooks.Count != 1 || hooks.Webhooks[0].ID != mobileHook.ID {
+		t.Errorf("expected only webhook of member project, got %+v", hooks.Webhooks)
+	}
+	hook := dto.CreateWebhookRequest{Project: "mobile-app", URL: "https://example.com/other"}
+	if status := env.doAs(dev, http.MethodPost, "/api/v1/webhooks", hook, nil); status != http.StatusCreated {
+		t.Errorf("expected developer to create webhook of project, got status %d", status)
+	}
+	hook.Project = "web-app"
+	if status := env.doAs(dev, http.MethodPost, "/api/v1/webhooks", hook, nil); status != http.StatusForbidden {
+		t.Errorf("expected developer not to create webhook of another project, got status %d", status)
+	}
+	if status := env.doAs(dev, http.MethodDelete, "/api/v1/webhooks/"+webHook.ID, nil, nil); status != http.StatusNotFound {
+		t.Errorf("expected webhook of another project to be hidden, got status %d", status)
+	}
+	query := url.Values{"request_id": {web}}
+	if status := env.doAs(dev, http.MethodGet, "/api/v1/webhooks/deliveries?"+query.Encode(), nil, nil); status != http.StatusNotFound {
+		t.Errorf("expected deliveries of another project to be hidden, got status %d", status)
+	}
+	if status := env.doAs(dev, http.MethodDelete, "/api/v1/webhooks/"+mobileHook.ID, nil, nil); status != http.StatusOK {
+		t.Errorf("expected developer to delete webhook of project, got status %d", status)
+	}
+	if status := env.doAs(dev, http.MethodDelete, "/api/v1/translations/bye", nil, nil); status != http.StatusForbidden {
+		t.Errorf("expected project developer not to delete shared keys, got status %d", status)
+	}
+	cacheAs := map[string]any{"translations": cache.Translations}
+	if status := env.doAs(dev, http.MethodPost, "/api/v1/translations/cache", cacheAs, nil); status != http.StatusForbidden {
+		t.Errorf("expected project developer not to change shared translations, got status %d", status)
+	}
+
+	// Translation keys belong to no project, so naming one the caller has a role in doesn't help
+	if status := env.doAs(dev, http.MethodDelete, "/api/v1/translations/bye?project=mobile-app", nil, nil); status != http.StatusForbidden {
+		t.Errorf("expected project given in query to be ignored, got status %d", status)
+	}
+	cacheAs["project"] = "mobile-app"
+	if status := env.doAs(dev, http.MethodPost, "/api/v1/translations/cache", cacheAs, nil); status != http.StatusForbidden {
+		t.Errorf("expected project given in cache body to be ignored, got status %d", status)
+	}
+	rollback := map[string]any{"key": "bye", "timestamp": time.Now().Add(-time.Hour).UTC().Format(time.RFC3339), "project": "mobile-app"}
+	if status := env.doAs(dev, http.MethodPost, "/api/v1/translations/rollback", rollback, nil); status != http.StatusForbidden {
+		t.Errorf("expected project given in rollback body to be ignored, got status %d", status)
+	}
+	if stored := env.storedKey("bye"); stored == nil || stored.Translations["es"] != "Adiós" {
+		t.Errorf("expected shared key to be left as it was, got %+v", stored)
+	}
+	if status := env.doAs(dev, http.MethodGet, "/api/v1/translations/history/bye", nil, nil); status != http.StatusOK {
+		t.Errorf("expected project member to read translations, got status %d", status)
+	}
+
+	// Members see requests of projects they have no role in as missing, and are refused actions their role lacks
+	viewer := idp.sign("RS256", idp.claims("vic", map[string]any{
+		"projects": []string{"mobile-app:viewer", "staging:translator"},
+	}))
+	if status := env.doAs(viewer, http.MethodPost, "/api/v1/translations/"+web+"/cancel", nil, nil); status != http.StatusNotFound {
+		t.Errorf("expected request of another project to be hidden, got status %d", status)
+	}
+	if status := env.doAs(viewer, http.MethodPost, "/api/v1/translations/"+mobile+"/cancel", nil, nil); status != http.StatusForbidden {
+		t.Errorf("expected viewer not to cancel request, got status %d", status)
+	}
+	if status := env.doAs(viewer, http.MethodGet, "/api/v1/webhooks", nil, nil); status != http.StatusForbidden {
+		t.Errorf("expected member not to manage webhooks, got status %d", status)
+	}
+
+	// Global developers delete keys, only admins manage the queue
+	globalDev := idp.sign("RS256", idp.claims("gus", map[string]any{"roles": []string{"developer"}}))
+	if status := env.doAs(globalDev, http.MethodGet, "/api/v1/admin/queue", nil, nil); status != http.StatusForbidden {
+		t.Errorf("expected developer not to manage queue, got status %d", status)
+	}
+	if status := env.doAs(globalDev, http.MethodDelete, "/api/v1/translations/bye", nil, nil); status != http.StatusNoContent {
+		t.Errorf("expected developer to delete keys, got status %d", status)
+	}
+}
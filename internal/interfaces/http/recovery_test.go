@@ -0,0 +1,139 @@
+package http_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"translation/internal/config"
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/infrastructure/memory"
+
+	"github.com/google/uuid"
+)
+
+// leaseClock makes request leases look expired while expired is set, as if the lease time passed
+type leaseClock struct {
+	domainTranslation.Repository
+	expired atomic.Bool
+}
+
+func (s *leaseClock) GetExpiredRequestLeases(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	if s.expired.Load() {
+		now = now.Add(time.Hour)
+	}
+	return s.Repository.GetExpiredRequestLeases(ctx, now)
+}
+
+func (s *leaseClock) GetRequestLease(ctx context.Context, requestID uuid.UUID) (*time.Time, error) {
+	until, err := s.Repository.GetRequestLease(ctx, requestID)
+	if err != nil || until == nil || !s.expired.Load() {
+		return until, err
+	}
+
+	past := until.Add(-time.Hour)
+	return &past, nil
+}
+
+// useLeaseClock makes services read request leases through clock
+func (e *testEnv) useLeaseClock() *leaseClock {
+	clock := &leaseClock{Repository: e.repo}
+	e.storage = clock
+	e.restart()
+	return clock
+}
+
+func TestStaleRequestResumesFromCheckpoint(t *testing.T) {
+	env := newTestEnv(t)
+	env.workers = config.WorkerConfig{Concurrency: 1, ChunkSize: 1}
+	clock := env.useLeaseClock()
+	env.translator.SetDelay(30 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := env.runConsumer(ctx)
+
+	sourceData := map[string]string{"a": "A", "b": "B", "c": "C", "d": "D"}
+	id := env.createRequest(sourceData, "es")
+	env.waitForTranslations(2)
+
+	// Worker dies halfway, its queued chunks are lost
+	cancel()
+	<-done
+	env.restart()
+
+	translated := env.translator.Calls()
+	if translated >= len(sourceData) {
+		t.Fatalf("expected request to be interrupted, %d keys translated", translated)
+	}
+
+	// Lease of the request is still alive, so it is left to its worker
+	if err := env.appService.RecoverIncompleteRequests(context.Background()); err != nil {
+		t.Fatalf("failed to recover requests: %v", err)
+	}
+	if requeued, _ := env.appService.RequeueStaleRequests(context.Background()); requeued != 0 {
+		t.Fatalf("expected request with live lease not to be requeued, %d requeued", requeued)
+	}
+
+	clock.expired.Store(true)
+	requeued, err := env.appService.RequeueStaleRequests(context.Background())
+	if err != nil || requeued != 1 {
+		t.Fatalf("expected stale request to be requeued, got %d: %v", requeued, err)
+	}
+	clock.expired.Store(false)
+
+	env.startConsumer()
+	resp := env.waitForStatus(id, domainTranslation.StatusCompleted)
+
+	if calls := env.translator.Calls(); calls != len(sourceData) {
+		t.Errorf("expected every key to be translated once, got %d translator calls", calls)
+	}
+	for key, value := range sourceData {
+		if got, want := resp.TranslatedData["es"][key], memory.FakeTranslation(value, "es"); got != want {
+			t.Errorf("translation of %s = %q, want %q", key, got, want)
+		}
+		// Keys translated before the worker died keep their outcome instead of being reported as cached
+		if result := resultOf(resp, key, "es"); result == nil || result.Outcome != string(domainTranslation.KeyOutcomeTranslated) {
+			t.Errorf("expected %s to be translated, got %+v", key, result)
+		}
+	}
+}
+
+func TestIncompleteRequestsAreRecoveredOnce(t *testing.T) {
+	env := newTestEnv(t)
+
+	env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	env.createRequest(map[string]string{"bye": "Bye"}, "fr")
+	env.restart()
+
+	// Two instances start one after another
+	for i := 0; i < 2; i++ {
+		if err := env.appService.RecoverIncompleteRequests(context.Background()); err != nil {
+			t.Fatalf("failed to recover requests: %v", err)
+		}
+	}
+
+	if queued := env.getQueueInfo().Queued; queued != 2 {
+		t.Errorf("expected each request to be queued once, got %d queued tasks", queued)
+	}
+}
+
+func TestRecoverySkipsQueuedRequests(t *testing.T) {
+	env := newTestEnv(t)
+
+	env.createRequest(map[string]string{"hello": "Hello"}, "es")
+
+	// Tasks survive restart of the instance, e.g. in a shared queue
+	if err := env.appService.RecoverIncompleteRequests(context.Background()); err != nil {
+		t.Fatalf("failed to recover requests: %v", err)
+	}
+	if queued := env.getQueueInfo().Queued; queued != 1 {
+		t.Errorf("expected queued request not to be queued again, got %d queued tasks", queued)
+	}
+
+	// Finished recovery doesn't hold off instances starting later
+	acquired, err := env.locker.AcquireLock(context.Background(), "recovery", time.Minute)
+	if err != nil || !acquired {
+		t.Errorf("expected recovery lock to be released, got %v: %v", acquired, err)
+	}
+}
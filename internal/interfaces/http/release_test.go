@@ -0,0 +1,119 @@
+package http_test
+
+import (
+	"net/http"
+	"testing"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+)
+
+// createRelease freezes current translations through the API
+func (e *testEnv) createRelease(name string) dto.ReleaseInfo {
+	e.t.Helper()
+
+	var resp dto.ReleaseInfo
+	if status := e.do(http.MethodPost, "/api/v1/releases", dto.CreateReleaseRequest{Name: name}, &resp); status != http.StatusCreated {
+		e.t.Fatalf("expected status 201, got %d", status)
+	}
+
+	return resp
+}
+
+func TestReleaseIsImmutableSnapshot(t *testing.T) {
+	env := newTestEnv(t)
+
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello", "bye": "Bye"},
+		"es": {"hello": "Hola", "bye": "Adiós"},
+	})
+
+	info := env.createRelease("v1.0.0")
+	if info.KeyCount != 2 || len(info.Languages) != 2 {
+		t.Errorf("unexpected release summary: %+v", info)
+	}
+
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello"},
+		"es": {"hello": "Hola Mundo"},
+	})
+
+	var release dto.GetReleaseResponse
+	if status := env.do(http.MethodGet, "/api/v1/releases/v1.0.0", nil, &release); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if got := release.Translations["es"]["hello"]; got != "Hola" {
+		t.Errorf("expected release to keep frozen translation, got %q", got)
+	}
+	if got := release.SourceData["bye"]; got != "Bye" {
+		t.Errorf("expected release to contain source values, got %q", got)
+	}
+
+	if status := env.do(http.MethodPost, "/api/v1/releases", dto.CreateReleaseRequest{Name: "v1.0.0"}, nil); status != http.StatusConflict {
+		t.Errorf("expected status 409 for existing release, got %d", status)
+	}
+}
+
+func TestReleaseValidationAndLookup(t *testing.T) {
+	env := newTestEnv(t)
+
+	if status := env.do(http.MethodPost, "/api/v1/releases", dto.CreateReleaseRequest{Name: "bad name!"}, nil); status != http.StatusBadRequest {
+		t.Errorf("expected status 400 for invalid name, got %d", status)
+	}
+	if status := env.do(http.MethodGet, "/api/v1/releases/v9.9.9", nil, nil); status != http.StatusNotFound {
+		t.Errorf("expected status 404 for unknown release, got %d", status)
+	}
+
+	env.createRelease("v1.0.0")
+	env.createRelease("v1.1.0")
+
+	var list dto.GetReleasesResponse
+	env.do(http.MethodGet, "/api/v1/releases", nil, &list)
+	if list.Count != 2 || list.Releases[0].Name != "v1.0.0" || list.Releases[1].Name != "v1.1.0" {
+		t.Errorf("expected releases in creation order, got %+v", list.Releases)
+	}
+}
+
+func TestDiffReleases(t *testing.T) {
+	env := newTestEnv(t)
+	env.startConsumer()
+
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello", "bye": "Bye"},
+		"es": {"hello": "Hola", "bye": "Adiós"},
+	})
+	env.createRelease("v1.0.0")
+
+	if status := env.do(http.MethodDelete, "/api/v1/translations/bye", nil, nil); status != http.StatusNoContent {
+		t.Fatalf("expected status 204, got %d", status)
+	}
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"hello": "Hello"},
+		"es": {"hello": "Hola Mundo"},
+	})
+	id := env.createRequest(map[string]string{"welcome": "Welcome"}, "es")
+	env.waitForStatus(id, domainTranslation.StatusCompleted)
+	env.createRelease("v1.1.0")
+
+	var diff dto.ReleaseDiffResponse
+	if status := env.do(http.MethodGet, "/api/v1/releases/diff?from=v1.0.0&to=v1.1.0", nil, &diff); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+
+	es := diff.Languages["es"]
+	if es.Added["welcome"] == "" || es.Removed["bye"] != "Adiós" {
+		t.Errorf("unexpected added/removed Spanish keys: %+v", es)
+	}
+	if change := es.Changed["hello"]; change.From != "Hola" || change.To != "Hola Mundo" {
+		t.Errorf("unexpected changed Spanish key: %+v", change)
+	}
+
+	source := diff.Languages[domainTranslation.SourceLanguage]
+	if source.Added["welcome"] != "Welcome" || source.Removed["bye"] != "Bye" || len(source.Changed) != 0 {
+		t.Errorf("unexpected source diff: %+v", source)
+	}
+
+	if status := env.do(http.MethodGet, "/api/v1/releases/diff?from=v1.0.0&to=v2.0.0", nil, nil); status != http.StatusNotFound {
+		t.Errorf("expected status 404 for unknown release, got %d", status)
+	}
+}
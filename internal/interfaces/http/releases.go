@@ -0,0 +1,231 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CreateRelease freezes current translations into an immutable named release
+// @Summary Create release
+// @Description Freeze current source values and translations into an immutable named release
+// @Tags releases
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.CreateReleaseRequest true "Release name and description"
+// @Success 201 {object} dto.ReleaseInfo
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/releases [post]
+func (h *Handler) CreateRelease(c *fiber.Ctx) error {
+	var req dto.CreateReleaseRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Invalid request body",
+		})
+	}
+
+	release, err := h.appService.CreateRelease(c.Context(), req.Name, req.Description)
+	if err != nil {
+		if err.Error() == "invalid release name" {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "Release name must be 1-64 letters, digits, dots, dashes or underscores",
+			})
+		}
+		if err.Error() == "release already exists" {
+			return c.Status(http.StatusConflict).JSON(dto.ErrorResponse{
+				Error: err.Error(),
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to create release: %v", err),
+		})
+	}
+
+	auditChange(c, release.Name, "", nil, map[string]any{
+		"keys":      len(release.SourceData),
+		"languages": release.Languages(),
+	})
+
+	return c.Status(http.StatusCreated).JSON(toReleaseInfo(release))
+}
+
+// GetReleases lists all releases
+// @Summary List releases
+// @Description Get all releases ordered by creation time
+// @Tags releases
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} dto.GetReleasesResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/releases [get]
+func (h *Handler) GetReleases(c *fiber.Ctx) error {
+	releases, err := h.appService.GetAllReleases(c.Context())
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to get releases: %v", err),
+		})
+	}
+
+	infos := make([]dto.ReleaseInfo, 0, len(releases))
+	for _, release := range releases {
+		infos = append(infos, toReleaseInfo(release))
+	}
+
+	return c.JSON(dto.GetReleasesResponse{
+		Releases: infos,
+		Count:    len(infos),
+	})
+}
+
+// GetRelease gets release bundle by name
+// @Summary Get release
+// @Description Get source values and translations frozen in release
+// @Tags releases
+// @Produce json
+// @Security ApiKeyAuth
+// @Param name path string true "Release name"
+// @Success 200 {object} dto.GetReleaseResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/releases/{name} [get]
+func (h *Handler) GetRelease(c *fiber.Ctx) error {
+	release, err := h.appService.GetRelease(c.Context(), c.Params("name"))
+	if err != nil {
+		if err.Error() == "release not found" {
+			return c.Status(http.StatusNotFound).JSON(dto.ErrorResponse{
+				Error: "Release not found",
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to get release: %v", err),
+		})
+	}
+
+	return c.JSON(dto.GetReleaseResponse{
+		ReleaseInfo:  toReleaseInfo(release),
+		SourceData:   release.SourceData,
+		Translations: release.Translations,
+	})
+}
+
+// PublishRelease makes release available for over-the-air delivery
+// @Summary Publish release
+// @Description Make release available through OTA endpoints, the most recently published release is served as "latest"
+// @Tags releases
+// @Produce json
+// @Security ApiKeyAuth
+// @Param name path string true "Release name"
+// @Success 200 {object} dto.ReleaseInfo
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/releases/{name}/publish [post]
+func (h *Handler) PublishRelease(c *fiber.Ctx) error {
+	release, err := h.appService.PublishRelease(c.Context(), c.Params("name"))
+	if err != nil {
+		if err.Error() == "release not found" {
+			return c.Status(http.StatusNotFound).JSON(dto.ErrorResponse{
+				Error: "Release not found",
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to publish release: %v", err),
+		})
+	}
+	auditChange(c, release.Name, "", nil, map[string]any{"published_at": release.PublishedAt})
+
+	return c.JSON(toReleaseInfo(release))
+}
+
+// DiffReleases compares two releases
+// @Summary Diff releases
+// @Description Get keys added, removed and changed between two releases per language ("source" for source values)
+// @Tags releases
+// @Produce json
+// @Security ApiKeyAuth
+// @Param from query string true "Base release name"
+// @Param to query string true "Target release name"
+// @Success 200 {object} dto.ReleaseDiffResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/releases/diff [get]
+func (h *Handler) DiffReleases(c *fiber.Ctx) error {
+	from, to := c.Query("from"), c.Query("to")
+	if from == "" || to == "" {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Both from and to releases are required",
+		})
+	}
+
+	diff, err := h.appService.DiffReleases(c.Context(), from, to)
+	if err != nil {
+		if err.Error() == "release not found" {
+			return c.Status(http.StatusNotFound).JSON(dto.ErrorResponse{
+				Error: "Release not found",
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to diff releases: %v", err),
+		})
+	}
+
+	response := dto.ReleaseDiffResponse{
+		From:      diff.From,
+		To:        diff.To,
+		Languages: make(map[string]dto.LanguageDiffInfo, len(diff.Languages)),
+	}
+	for lang, languageDiff := range diff.Languages {
+		changed := make(map[string]dto.ValueChangeInfo, len(languageDiff.Changed))
+		for key, change := range languageDiff.Changed {
+			changed[key] = dto.ValueChangeInfo{From: change.From, To: change.To}
+		}
+		response.Languages[lang] = dto.LanguageDiffInfo{
+			Added:   languageDiff.Added,
+			Removed: languageDiff.Removed,
+			Changed: changed,
+		}
+	}
+
+	return c.JSON(response)
+}
+
+// toReleaseInfo converts release to DTO summary
+func toReleaseInfo(release *domainTranslation.Release) dto.ReleaseInfo {
+	info := dto.ReleaseInfo{
+		Name:        release.Name,
+		Description: release.Description,
+		Languages:   release.Languages(),
+		KeyCount:    len(release.SourceData),
+		CreatedAt:   release.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if release.PublishedAt != nil {
+		publishedAt := release.PublishedAt.Format("2006-01-02T15:04:05Z")
+		info.PublishedAt = &publishedAt
+	}
+
+	return info
+}
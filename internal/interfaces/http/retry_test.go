@@ -0,0 +1,124 @@
+package http_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/infrastructure/memory"
+	"translation/internal/interfaces/http/dto"
+)
+
+// retryRequest retries translation request with given options, returning status and response
+func (e *testEnv) retryRequest(id string, req dto.RetryTranslationRequestRequest) (int, dto.RetryTranslationRequestResponse) {
+	e.t.Helper()
+
+	var resp dto.RetryTranslationRequestResponse
+	status := e.do(http.MethodPost, "/api/v1/translations/"+id+"/retry", req, &resp)
+	return status, resp
+}
+
+func TestRetryTranslatesOnlyFailedKeys(t *testing.T) {
+	env := newTestEnv(t)
+	env.translator.FailLanguage("de", errors.New("rate limit exceeded"))
+	env.startConsumer()
+
+	id := env.createRequest(map[string]string{"hello": "Hello", "bye": "Bye"}, "es", "de")
+	env.waitForStatus(id, domainTranslation.StatusPartiallyCompleted)
+	calls := env.translator.Calls()
+
+	env.translator.FailLanguage("de", nil)
+	status, resp := env.retryRequest(id, dto.RetryTranslationRequestRequest{})
+	if status != http.StatusAccepted {
+		t.Fatalf("expected status 202, got %d", status)
+	}
+	if resp.Retried != 2 {
+		t.Errorf("expected 2 key languages to be retried, got %d", resp.Retried)
+	}
+
+	done := env.waitForStatus(id, domainTranslation.StatusCompleted)
+	if retried := env.translator.Calls() - calls; retried != 2 {
+		t.Errorf("expected only German keys to be translated again, got %d translator calls", retried)
+	}
+	if got := done.TranslatedData["de"]["hello"]; got != memory.FakeTranslation("Hello", "de") {
+		t.Errorf("expected retried German translation, got %q", got)
+	}
+	if got := done.TranslatedData["es"]["bye"]; got != memory.FakeTranslation("Bye", "es") {
+		t.Errorf("expected Spanish translation to be kept, got %q", got)
+	}
+	if done.Progress == nil || done.Progress.Done != 4 || done.Progress.Failed != 0 {
+		t.Errorf("expected all 4 key languages to be translated, got %+v", done.Progress)
+	}
+}
+
+func TestRetryForcesTranslationOfKeys(t *testing.T) {
+	env := newTestEnv(t)
+	env.startConsumer()
+
+	id := env.createRequest(map[string]string{"hello": "Hello", "bye": "Bye"}, "es", "fr")
+	env.waitForStatus(id, domainTranslation.StatusCompleted)
+	calls := env.translator.Calls()
+
+	// Nothing failed, so only forced key languages are retried
+	status, _ := env.retryRequest(id, dto.RetryTranslationRequestRequest{})
+	if status != http.StatusConflict {
+		t.Errorf("expected status 409 for request with nothing to retry, got %d", status)
+	}
+
+	status, resp := env.retryRequest(id, dto.RetryTranslationRequestRequest{Keys: []string{"hello"}})
+	if status != http.StatusAccepted {
+		t.Fatalf("expected status 202, got %d", status)
+	}
+	if resp.Retried != 2 {
+		t.Errorf("expected key to be retried in both languages, got %d", resp.Retried)
+	}
+	env.waitForStatus(id, domainTranslation.StatusCompleted)
+
+	status, resp = env.retryRequest(id, dto.RetryTranslationRequestRequest{Languages: []string{"fr"}})
+	if status != http.StatusAccepted {
+		t.Fatalf("expected status 202, got %d", status)
+	}
+	if resp.Retried != 2 {
+		t.Errorf("expected language to be retried for both keys, got %d", resp.Retried)
+	}
+	done := env.waitForStatus(id, domainTranslation.StatusCompleted)
+
+	if retried := env.translator.Calls() - calls; retried != 4 {
+		t.Errorf("expected forced key languages to be translated again, got %d translator calls", retried)
+	}
+	if got := done.TranslatedData["fr"]["bye"]; got != memory.FakeTranslation("Bye", "fr") {
+		t.Errorf("expected forced translation to be saved, got %q", got)
+	}
+}
+
+func TestRetryRejectsRequestInProgress(t *testing.T) {
+	env := newTestEnv(t)
+
+	// Without consumer request stays pending
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	if status, _ := env.retryRequest(id, dto.RetryTranslationRequestRequest{}); status != http.StatusConflict {
+		t.Errorf("expected status 409 for pending request, got %d", status)
+	}
+	if queued := env.getQueueInfo().Queued; queued != 1 {
+		t.Errorf("expected request not to be queued again, got %d queued tasks", queued)
+	}
+}
+
+func TestRetryValidatesKeysAndLanguages(t *testing.T) {
+	env := newTestEnv(t)
+	env.startConsumer()
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	env.waitForStatus(id, domainTranslation.StatusCompleted)
+
+	if status, _ := env.retryRequest(id, dto.RetryTranslationRequestRequest{Languages: []string{"ja"}}); status != http.StatusBadRequest {
+		t.Errorf("expected status 400 for language outside request, got %d", status)
+	}
+	if status, _ := env.retryRequest(id, dto.RetryTranslationRequestRequest{Keys: []string{"missing"}}); status != http.StatusBadRequest {
+		t.Errorf("expected status 400 for key outside request, got %d", status)
+	}
+	if status, _ := env.retryRequest("00000000-0000-0000-0000-000000000000", dto.RetryTranslationRequestRequest{}); status != http.StatusNotFound {
+		t.Errorf("expected status 404 for unknown request, got %d", status)
+	}
+}
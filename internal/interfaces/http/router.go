@@ -1,25 +1,92 @@
 package http
 
 import (
+	domainTranslation "translation/internal/domain/translation"
+
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/compress"
 )
 
-// SetupRoutes configures all API routes
-func SetupRoutes(app *fiber.App, handler *Handler, apiKey string) {
+// SetupRoutes configures all API routes, users is nil when user tokens aren't accepted
+func SetupRoutes(app *fiber.App, handler *Handler, otaHandler *OTAHandler, users UserAuthenticator, apiKey string) {
 	// API v1 group
 	api := app.Group("/api/v1")
 
 	// Health check (public endpoint)
 	api.Get("/health", handler.HealthCheck)
 
+	// Requests authenticate with an API key or user token and are rate limited per key or user, routes
+	// authorize the action they perform. Mutating requests are recorded in audit log.
+	auth := AuthMiddleware(handler.appService, users, apiKey)
+	limit := RateLimit(handler.appService)
+	record := Audit(handler.appService)
+
 	// Translation endpoints (protected with API key)
-	translations := api.Group("/translations", AuthMiddleware(apiKey))
-	translations.Post("/", handler.CreateTranslationRequest)
-	translations.Get("/incomplete", handler.GetIncompleteRequests)
-	translations.Get("/:id", handler.GetTranslationRequest)
-	translations.Post("/:id/cancel", handler.CancelTranslationRequest)
-	translations.Delete("/:key", handler.DeleteTranslationKey)
-	translations.Post("/cache", handler.CacheTranslations)
+	translations := api.Group("/translations", auth, limit, record)
+	translations.Post("/", Authorize(domainTranslation.ActionCreateRequests, projectFromBody), handler.CreateTranslationRequest)
+	translations.Get("/", Authorize(domainTranslation.ActionReadRequests, projectFromQuery), handler.ListTranslationRequests)
+	translations.Get("/incomplete", Authorize(domainTranslation.ActionReadRequests, nil), handler.GetIncompleteRequests)
+	translations.Get("/history/:key", Authorize(domainTranslation.ActionReadTranslations, nil), handler.GetKeyHistory)
+	translations.Post("/rollback", Authorize(domainTranslation.ActionWriteTranslations, nil), handler.RollbackTranslations)
+	translations.Get("/changes", Authorize(domainTranslation.ActionReadTranslations, nil), handler.GetChanges)
+	translations.Get("/:id", Authorize(domainTranslation.ActionReadRequests, handler.requestProject), handler.GetTranslationRequest)
+	translations.Get("/:id/events", Authorize(domainTranslation.ActionReadRequests, handler.requestProject), handler.StreamRequestEvents)
+	translations.Post("/:id/cancel", Authorize(domainTranslation.ActionCancelRequests, handler.requestProject), handler.CancelTranslationRequest)
+	translations.Post("/:id/retry", Authorize(domainTranslation.ActionRetryRequests, handler.requestProject), handler.RetryTranslationRequest)
+	translations.Delete("/:key", Authorize(domainTranslation.ActionDeleteTranslations, nil), handler.DeleteTranslationKey)
+	translations.Post("/cache", Authorize(domainTranslation.ActionWriteTranslations, nil), handler.CacheTranslations)
+
+	// Synchronous translation of small payloads (protected with API key)
+	api.Post("/translate", auth, limit, record, Authorize(domainTranslation.ActionCreateRequests, projectFromBody), handler.Translate)
+
+	// Release endpoints (protected with API key)
+	releases := api.Group("/releases", auth, limit, record)
+	releases.Post("/", Authorize(domainTranslation.ActionCreateReleases, nil), handler.CreateRelease)
+	releases.Get("/", Authorize(domainTranslation.ActionReadReleases, nil), handler.GetReleases)
+	releases.Get("/diff", Authorize(domainTranslation.ActionReadReleases, nil), handler.DiffReleases)
+	releases.Get("/:name", Authorize(domainTranslation.ActionReadReleases, nil), handler.GetRelease)
+	releases.Post("/:name/publish", Authorize(domainTranslation.ActionPublishReleases, nil), handler.PublishRelease)
+
+	// Webhook endpoints (protected with API key)
+	manageWebhooks := func(resolve projectResolver) fiber.Handler {
+		return Authorize(domainTranslation.ActionManageWebhooks, resolve)
+	}
+	webhooks := api.Group("/webhooks", auth, limit, record)
+	webhooks.Post("/", manageWebhooks(projectFromBody), handler.CreateWebhook)
+	webhooks.Get("/", manageWebhooks(projectFromQuery), handler.GetWebhooks)
+	webhooks.Get("/deliveries", manageWebhooks(handler.deliveriesProject), handler.GetDeliveries)
+	webhooks.Get("/deliveries/:id", manageWebhooks(handler.deliveryProject), handler.GetDelivery)
+	webhooks.Post("/deliveries/:id/redeliver", manageWebhooks(handler.deliveryProject), handler.RedeliverWebhook)
+	webhooks.Delete("/:id", manageWebhooks(handler.webhookProject), handler.DeleteWebhook)
+
+	// Usage of rate limit and project quotas (protected with API key)
+	api.Get("/usage", auth, limit, Authorize(domainTranslation.ActionReadRequests, projectParam), handler.GetUsage)
+
+	// Admin endpoints (protected with API key)
+	adminGroup := api.Group("/admin", auth, limit, record)
+	manageQueue := Authorize(domainTranslation.ActionManageQueue, nil)
+	adminGroup.Get("/queue", manageQueue, handler.GetQueueInfo)
+	adminGroup.Get("/dead-letters", manageQueue, handler.GetDeadLetters)
+	adminGroup.Post("/dead-letters/requeue", manageQueue, handler.RequeueDeadLetters)
+	adminGroup.Delete("/dead-letters", manageQueue, handler.PurgeDeadLetters)
+	manageAPIKeys := Authorize(domainTranslation.ActionManageAPIKeys, nil)
+	adminGroup.Post("/api-keys", manageAPIKeys, handler.CreateAPIKey)
+	adminGroup.Get("/api-keys", manageAPIKeys, handler.GetAPIKeys)
+	adminGroup.Get("/api-keys/:id", manageAPIKeys, handler.GetAPIKey)
+	adminGroup.Post("/api-keys/:id/rotate", manageAPIKeys, handler.RotateAPIKey)
+	adminGroup.Delete("/api-keys/:id", manageAPIKeys, handler.RevokeAPIKey)
+	readAuditLog := Authorize(domainTranslation.ActionReadAuditLog, nil)
+	adminGroup.Get("/audit", readAuditLog, handler.ListAuditEntries)
+	adminGroup.Get("/audit/export", readAuditLog, handler.ExportAuditEntries)
+
+	// Over-the-air delivery endpoints (read-only, public unless configured otherwise)
+	otaMiddleware := []fiber.Handler{compress.New()}
+	if !otaHandler.Public() {
+		otaMiddleware = append([]fiber.Handler{auth, limit, Authorize(domainTranslation.ActionReadReleases, nil)}, otaMiddleware...)
+	}
+	ota := api.Group("/ota", otaMiddleware...)
+	ota.Get("/releases/:name/manifest", otaHandler.GetManifest)
+	ota.Get("/releases/:name/locales/:locale", otaHandler.GetLocaleBundle)
 
 	// Swagger documentation with security support
 	app.Get("/swagger/*", SwaggerHandler())
@@ -0,0 +1,141 @@
+package http_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"translation/internal/config"
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+)
+
+// createPriorityRequest creates translation request of project with given priority
+func (e *testEnv) createPriorityRequest(project string, priority int, sourceData map[string]string, languages ...string) string {
+	e.t.Helper()
+
+	var resp dto.CreateTranslationRequestResponse
+	status := e.do(http.MethodPost, "/api/v1/translations", dto.CreateTranslationRequestRequest{
+		SourceData: sourceData,
+		Languages:  languages,
+		Project:    project,
+		Priority:   &priority,
+	}, &resp)
+	if status != http.StatusCreated {
+		e.t.Fatalf("expected status 201, got %d", status)
+	}
+
+	return resp.RequestID
+}
+
+// backfill returns source data with count keys
+func backfill(count int) map[string]string {
+	sourceData := make(map[string]string, count)
+	for i := 1; i <= count; i++ {
+		sourceData[fmt.Sprintf("backfill%03d", i)] = fmt.Sprintf("Backfill %d", i)
+	}
+	return sourceData
+}
+
+// waitForTranslations waits until translator was called at least count times
+func (e *testEnv) waitForTranslations(count int) {
+	e.t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for e.translator.Calls() < count {
+		if time.Now().After(deadline) {
+			e.t.Fatal("translation did not start")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestProjectsShareWorkersFairly(t *testing.T) {
+	env := newTestEnv(t)
+	env.workers = config.WorkerConfig{Concurrency: 1, ChunkSize: 1, ProjectMaxInFlight: 1}
+	env.restart()
+	env.translator.SetDelay(10 * time.Millisecond)
+	env.startConsumer()
+
+	large := env.createPriorityRequest("backfill", domainTranslation.DefaultPriority, backfill(30), "es")
+	env.waitForTranslations(1)
+
+	// Hotfix of another project waits for at most one chunk of the backfill instead of all of them
+	hotfix := env.createPriorityRequest("hotfix", domainTranslation.DefaultPriority, map[string]string{"fix": "Fix"}, "es")
+	env.waitForStatus(hotfix, domainTranslation.StatusCompleted)
+
+	if resp := env.getRequest(large); resp.Status == string(domainTranslation.StatusCompleted) {
+		t.Error("expected hotfix to complete before the backfill")
+	}
+	if calls := env.translator.Calls(); calls > 5 {
+		t.Errorf("expected hotfix to be translated right after the current backfill chunk, got %d translator calls", calls)
+	}
+
+	env.waitForStatus(large, domainTranslation.StatusCompleted)
+}
+
+func TestHigherPriorityRequestsGoFirst(t *testing.T) {
+	env := newTestEnv(t)
+	env.workers = config.WorkerConfig{Concurrency: 1, ChunkSize: 1, ProjectMaxInFlight: 1}
+	env.restart()
+	env.translator.SetDelay(10 * time.Millisecond)
+	env.startConsumer()
+
+	low := env.createPriorityRequest("mobile-app", 0, backfill(20), "es")
+	env.waitForTranslations(1)
+
+	// Urgent chunks of the same project jump ahead of its backlog
+	urgent := env.createPriorityRequest("mobile-app", domainTranslation.MaxPriority, map[string]string{"fix": "Fix"}, "es")
+	resp := env.waitForStatus(urgent, domainTranslation.StatusCompleted)
+
+	if resp.Priority != domainTranslation.MaxPriority {
+		t.Errorf("expected priority %d, got %d", domainTranslation.MaxPriority, resp.Priority)
+	}
+	if status := env.getRequest(low).Status; status == string(domainTranslation.StatusCompleted) {
+		t.Error("expected urgent request to complete before the low priority one")
+	}
+
+	env.waitForStatus(low, domainTranslation.StatusCompleted)
+}
+
+func TestProjectWeightsScaleLimit(t *testing.T) {
+	env := newTestEnv(t)
+	env.workers = config.WorkerConfig{
+		Concurrency:        1,
+		ChunkSize:          1,
+		ProjectMaxInFlight: 1,
+		ProjectWeights:     map[string]int{"weighted": 3},
+	}
+	env.restart()
+	env.translator.SetDelay(50 * time.Millisecond)
+	env.startConsumer()
+
+	env.createPriorityRequest("weighted", domainTranslation.DefaultPriority, backfill(10), "es")
+	env.waitForTranslations(1)
+
+	// One chunk is being translated, the rest of the weighted limit waits in the queue
+	if queued, _ := env.queue.GetQueueInfo(); queued != 2 {
+		t.Errorf("expected 2 queued chunks, got %d", queued)
+	}
+}
+
+func TestPriorityValidation(t *testing.T) {
+	env := newTestEnv(t)
+
+	for _, priority := range []int{-1, domainTranslation.MaxPriority + 1} {
+		status := env.do(http.MethodPost, "/api/v1/translations", dto.CreateTranslationRequestRequest{
+			SourceData: map[string]string{"hello": "Hello"},
+			Languages:  []string{"es"},
+			Priority:   &priority,
+		}, nil)
+		if status != http.StatusBadRequest {
+			t.Errorf("expected status 400 for priority %d, got %d", priority, status)
+		}
+	}
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	if resp := env.getRequest(id); resp.Priority != domainTranslation.DefaultPriority {
+		t.Errorf("expected default priority %d, got %d", domainTranslation.DefaultPriority, resp.Priority)
+	}
+}
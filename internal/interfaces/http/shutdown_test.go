@@ -0,0 +1,54 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainTranslation "translation/internal/domain/translation"
+)
+
+func TestStoppedConsumerFinishesTasksInProgress(t *testing.T) {
+	env := newTestEnv(t)
+	env.translator.SetDelay(200 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := env.runConsumer(ctx)
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	env.waitForTranslations(1)
+
+	// Stop the consumer while the chunk is being translated
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("consumer did not stop")
+	}
+
+	resp := env.getRequest(id)
+	if resp.Status != string(domainTranslation.StatusCompleted) {
+		t.Fatalf("expected task in progress to complete before consumer stopped, got status %s", resp.Status)
+	}
+	if calls := env.translator.Calls(); calls != 1 {
+		t.Errorf("expected translation to run once, got %d calls", calls)
+	}
+}
+
+func TestStoppedConsumerTakesNoNewTasks(t *testing.T) {
+	env := newTestEnv(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	<-env.runConsumer(ctx)
+
+	id := env.createRequest(map[string]string{"hello": "Hello"}, "es")
+	time.Sleep(50 * time.Millisecond)
+
+	if status := env.getRequest(id).Status; status != string(domainTranslation.StatusPending) {
+		t.Errorf("expected request to stay pending, got %s", status)
+	}
+	if queued, _ := env.queue.GetQueueInfo(); queued != 1 {
+		t.Errorf("expected task to stay queued, got %d queued tasks", queued)
+	}
+}
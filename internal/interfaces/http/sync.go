@@ -0,0 +1,122 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Translate translates a few keys synchronously
+// @Summary Translate synchronously
+// @Description Translate a small payload inline instead of queueing it: cached translations are reused, the rest are translated and saved like queued requests are, and the translations are returned. The number of keys times languages is limited and the request times out; translations saved before the timeout are kept.
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.TranslateRequest true "Keys and languages to translate"
+// @Success 200 {object} dto.TranslateResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 413 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Failure 504 {object} dto.ErrorResponse
+// @Router /api/v1/translate [post]
+func (h *Handler) Translate(c *fiber.Ctx) error {
+	var req dto.TranslateRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Invalid request body",
+		})
+	}
+
+	if len(req.SourceData) == 0 {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Source data is required",
+		})
+	}
+
+	if len(req.Languages) == 0 {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "At least one language is required",
+		})
+	}
+
+	// Keys starting with @ are metadata and never translated
+	keyCount := 0
+	for key := range req.SourceData {
+		if !strings.HasPrefix(key, "@") {
+			keyCount++
+		}
+	}
+	if keyCount*len(req.Languages) > h.syncConfig.MaxTranslations {
+		return c.Status(http.StatusRequestEntityTooLarge).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("At most %d translations (keys times languages) can be translated synchronously, use POST /api/v1/translations", h.syncConfig.MaxTranslations),
+		})
+	}
+
+	keys, characters := domainTranslation.RequestUsage(req.SourceData, req.Languages)
+	if ok, err := h.consumeQuotas(c, req.Project, keys, characters); !ok {
+		return err
+	}
+
+	options := domainTranslation.RequestOptions{
+		Project:   req.Project,
+		CreatedBy: principalID(c),
+	}
+	request, err := h.appService.TranslateSync(c.Context(), req.SourceData, req.Languages, options, h.syncConfig.Timeout)
+	if err != nil {
+		if err.Error() == "synchronous translation is not available" {
+			h.appService.RefundQuotas(c.Context(), req.Project, keys, characters)
+			return c.Status(http.StatusServiceUnavailable).JSON(dto.ErrorResponse{
+				Error: "Synchronous translation is not available, OPENAI_API_KEY is not configured",
+			})
+		}
+		if err.Error() == "synchronous translation timed out" {
+			return c.Status(http.StatusGatewayTimeout).JSON(dto.ErrorResponse{
+				Error: fmt.Sprintf("Translation did not finish within %s, use POST /api/v1/translations", h.syncConfig.Timeout),
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to translate: %v", err),
+		})
+	}
+
+	translations, err := h.appService.GetTranslatedDataForRequestKeys(c.Context(), request.SourceData, request.Languages)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to get translations: %v", err),
+		})
+	}
+
+	auditChange(c, request.ID.String(), request.Project, nil, map[string]any{
+		"status":    string(request.Status),
+		"languages": request.Languages,
+		"keys":      len(request.SourceData),
+	})
+
+	response := dto.TranslateResponse{
+		RequestID:    request.ID.String(),
+		Status:       string(request.Status),
+		Translations: translations,
+	}
+
+	progress, err := h.appService.GetRequestProgress(c.Context(), request)
+	if err != nil {
+		// Log error but don't fail the request
+		fmt.Printf("Failed to get request progress: %v\n", err)
+	} else {
+		response.Progress = toRequestProgressInfo(progress)
+		response.Failed = toKeyResultInfos(progress.Results, string(domainTranslation.KeyOutcomeFailed))
+	}
+
+	return c.JSON(response)
+}
@@ -0,0 +1,226 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/infrastructure/memory"
+	"translation/internal/interfaces/http/dto"
+)
+
+func TestTranslateSyncReturnsTranslations(t *testing.T) {
+	env := newTestEnv(t)
+	env.cacheTranslations(map[string]map[string]string{
+		"en": {"save": "Save"},
+		"es": {"save": "Guardar"},
+	})
+
+	// No consumer runs, translation happens within the request
+	var resp dto.TranslateResponse
+	status := env.do(http.MethodPost, "/api/v1/translate", dto.TranslateRequest{
+		SourceData: map[string]string{"save": "Save", "cancel": "Cancel", "@cancel": "metadata"},
+		Languages:  []string{"es", "fr"},
+	}, &resp)
+	if status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+
+	if resp.Status != string(domainTranslation.StatusCompleted) {
+		t.Errorf("expected completed status, got %s", resp.Status)
+	}
+	want := map[string]map[string]string{
+		"es": {"save": "Guardar", "cancel": memory.FakeTranslation("Cancel", "es")},
+		"fr": {"save": memory.FakeTranslation("Save", "fr"), "cancel": memory.FakeTranslation("Cancel", "fr")},
+	}
+	for lang, keys := range want {
+		for key, value := range keys {
+			if got := resp.Translations[lang][key]; got != value {
+				t.Errorf("expected %s translation of %s to be %q, got %q", lang, key, value, got)
+			}
+		}
+	}
+	if resp.Progress == nil || resp.Progress.Done != 3 || resp.Progress.Skipped != 1 {
+		t.Errorf("expected 3 translated and 1 cached key, got %+v", resp.Progress)
+	}
+	if calls := env.translator.Calls(); calls != 3 {
+		t.Errorf("expected cached translation to be reused, got %d translator calls", calls)
+	}
+
+	// Translations are saved like those of queued requests
+	if stored := env.storedKey("cancel"); stored == nil || stored.Translations["fr"] != want["fr"]["cancel"] {
+		t.Errorf("expected translation to be saved, got %+v", stored)
+	}
+	if request := env.getRequest(resp.RequestID); request.Status != string(domainTranslation.StatusCompleted) {
+		t.Errorf("expected request to be recorded as completed, got %s", request.Status)
+	}
+	if queued := env.getQueueInfo().Queued; queued != 0 {
+		t.Errorf("expected nothing to be queued, got %d tasks", queued)
+	}
+}
+
+func TestTranslateSyncReportsFailedKeys(t *testing.T) {
+	env := newTestEnv(t)
+	env.translator.FailLanguage("de", errors.New("rate limit exceeded"))
+
+	var resp dto.TranslateResponse
+	status := env.do(http.MethodPost, "/api/v1/translate", dto.TranslateRequest{
+		SourceData: map[string]string{"save": "Save"},
+		Languages:  []string{"es", "de"},
+	}, &resp)
+	if status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+
+	if resp.Status != string(domainTranslation.StatusPartiallyCompleted) {
+		t.Errorf("expected partially completed status, got %s", resp.Status)
+	}
+	if len(resp.Failed) != 1 || resp.Failed[0].Language != "de" || resp.Failed[0].Error != "rate limit exceeded" {
+		t.Errorf("expected failed German translation, got %+v", resp.Failed)
+	}
+}
+
+func TestTranslateSyncLimitsPayloadSize(t *testing.T) {
+	env := newTestEnv(t)
+
+	// 4 keys times 3 languages exceed the limit of 10 translations
+	status := env.do(http.MethodPost, "/api/v1/translate", dto.TranslateRequest{
+		SourceData: map[string]string{"a": "A", "b": "B", "c": "C", "d": "D"},
+		Languages:  []string{"es", "fr", "de"},
+	}, nil)
+	if status != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected status 413, got %d", status)
+	}
+	if calls := env.translator.Calls(); calls != 0 {
+		t.Errorf("expected nothing to be translated, got %d translator calls", calls)
+	}
+}
+
+func TestTranslateSyncTimesOut(t *testing.T) {
+	env := newTestEnv(t)
+	env.syncConfig.Timeout = 50 * time.Millisecond
+	env.restart()
+	env.translator.SetDelay(5 * time.Second)
+
+	started := time.Now()
+	status := env.do(http.MethodPost, "/api/v1/translate", dto.TranslateRequest{
+		SourceData: map[string]string{"save": "Save"},
+		Languages:  []string{"es"},
+	}, nil)
+	if status != http.StatusGatewayTimeout {
+		t.Fatalf("expected status 504, got %d", status)
+	}
+	if elapsed := time.Since(started); elapsed > time.Second {
+		t.Errorf("expected translation to be aborted at the timeout, took %s", elapsed)
+	}
+
+	if stored := env.storedKey("save"); stored == nil || stored.Translations["es"] != "" {
+		t.Errorf("expected aborted translation not to be saved, got %+v", stored)
+	}
+
+	var incomplete dto.GetIncompleteRequestsResponse
+	env.do(http.MethodGet, "/api/v1/translations/incomplete", nil, &incomplete)
+	if len(incomplete.Requests) != 0 {
+		t.Errorf("expected timed out request to be failed, got incomplete %+v", incomplete.Requests)
+	}
+}
+
+func TestTranslateSyncStopsWhenCancelled(t *testing.T) {
+	env := newTestEnv(t)
+	env.translator.SetDelay(5 * time.Second)
+
+	// Like a process serving only the API, no consumer runs
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	if err := env.appService.StartCancellationWatcher(ctx); err != nil {
+		t.Fatalf("failed to start cancellation watcher: %v", err)
+	}
+
+	type result struct {
+		status int
+		resp   dto.TranslateResponse
+	}
+	done := make(chan result, 1)
+	started := time.Now()
+	go func() {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/translate", strings.NewReader(`{"source_data":{"save":"Save"},"languages":["es"]}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+testAPIKey)
+
+		resp, err := env.app.Test(req, -1)
+		if err != nil {
+			t.Errorf("synchronous translation failed: %v", err)
+			done <- result{}
+			return
+		}
+		defer resp.Body.Close()
+
+		var r result
+		r.status = resp.StatusCode
+		if err := json.NewDecoder(resp.Body).Decode(&r.resp); err != nil {
+			t.Errorf("failed to decode response: %v", err)
+		}
+		done <- r
+	}()
+
+	var id string
+	for deadline := time.Now().Add(5 * time.Second); id == ""; {
+		if time.Now().After(deadline) {
+			t.Fatal("synchronous translation did not start processing")
+		}
+		for _, request := range env.listRequests(nil).Requests {
+			if request.Status == string(domainTranslation.StatusProcessing) {
+				id = request.RequestID
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	env.cancelRequest(id, dto.CancelTranslationRequestRequest{})
+
+	select {
+	case r := <-done:
+		if r.status != http.StatusOK || r.resp.RequestID != id || r.resp.Status != string(domainTranslation.StatusCancelled) {
+			t.Errorf("expected cancelled request returned, got status %d and %+v", r.status, r.resp)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected synchronous translation to stop once its request was cancelled")
+	}
+	if elapsed := time.Since(started); elapsed > 2*time.Second {
+		t.Errorf("expected translation to be aborted at the cancellation, took %s", elapsed)
+	}
+
+	if stored := env.storedKey("save"); stored == nil || stored.Translations["es"] != "" {
+		t.Errorf("expected aborted translation not to be saved, got %+v", stored)
+	}
+	if request := env.getRequest(id); request.Status != string(domainTranslation.StatusCancelled) {
+		t.Errorf("expected request to stay cancelled, got %s", request.Status)
+	}
+}
+
+func TestTranslateSyncReturnsRequestCancelledBeforeCompletion(t *testing.T) {
+	env := newTestEnv(t)
+	env.storage = &cancelOnFinish{Repository: env.repo}
+	env.restart()
+
+	var resp dto.TranslateResponse
+	status := env.do(http.MethodPost, "/api/v1/translate", dto.TranslateRequest{
+		SourceData: map[string]string{"save": "Save"},
+		Languages:  []string{"es"},
+	}, &resp)
+	if status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+
+	if resp.Status != string(domainTranslation.StatusCancelled) {
+		t.Errorf("expected cancelled status, got %s", resp.Status)
+	}
+	if request := env.getRequest(resp.RequestID); request.Status != string(domainTranslation.StatusCancelled) {
+		t.Errorf("expected request to stay cancelled, got %s", request.Status)
+	}
+}
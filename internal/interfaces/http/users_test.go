@@ -0,0 +1,240 @@
+package http_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"translation/internal/infrastructure/oidc"
+	"translation/internal/interfaces/http/dto"
+)
+
+const (
+	testIssuer   = "https://id.example.com"
+	testAudience = "translation-service"
+)
+
+// identityProvider signs user tokens with keys published in a local JWKS file
+type identityProvider struct {
+	t      *testing.T
+	rsaKey *rsa.PrivateKey
+	ecKey  *ecdsa.PrivateKey
+}
+
+// useIdentityProvider makes API accept tokens of a new identity provider
+func (e *testEnv) useIdentityProvider() *identityProvider {
+	e.t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		e.t.Fatalf("failed to generate RSA key: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		e.t.Fatalf("failed to generate EC key: %v", err)
+	}
+
+	encode := func(n *big.Int) string {
+		return base64.RawURLEncoding.EncodeToString(n.Bytes())
+	}
+	jwks, _ := json.Marshal(map[string]any{"keys": []map[string]string{
+		{"kty": "RSA", "kid": "rsa-1", "use": "sig", "alg": "RS256", "n": encode(rsaKey.N), "e": encode(big.NewInt(int64(rsaKey.E)))},
+		{"kty": "EC", "kid": "ec-1", "use": "sig", "crv": "P-256", "x": encode(ecKey.X), "y": encode(ecKey.Y)},
+	}})
+	path := filepath.Join(e.t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, jwks, 0o600); err != nil {
+		e.t.Fatalf("failed to write JWKS: %v", err)
+	}
+
+	verifier, err := oidc.NewVerifier("", path, oidc.Options{
+		Issuer:          testIssuer,
+		Audience:        testAudience,
+		RolesClaim:      "roles",
+		ProjectsClaim:   "projects",
+		RefreshInterval: time.Hour,
+	})
+	if err != nil {
+		e.t.Fatalf("failed to create verifier: %v", err)
+	}
+	e.users = verifier
+	e.restart()
+
+	return &identityProvider{t: e.t, rsaKey: rsaKey, ecKey: ecKey}
+}
+
+// claims returns valid claims of user
+func (p *identityProvider) claims(subject string, extra map[string]any) map[string]any {
+	claims := map[string]any{
+		"sub":   subject,
+		"email": subject + "@example.com",
+		"iss":   testIssuer,
+		"aud":   []string{testAudience},
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}
+	for name, value := range extra {
+		claims[name] = value
+	}
+	return claims
+}
+
+// sign returns token with claims signed with algorithm RS256 or ES256
+func (p *identityProvider) sign(alg string, claims map[string]any) string {
+	p.t.Helper()
+
+	kid := map[string]string{"RS256": "rsa-1", "ES256": "ec-1"}[alg]
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var signature []byte
+	switch alg {
+	case "RS256":
+		var err error
+		if signature, err = rsa.SignPKCS1v15(rand.Reader, p.rsaKey, crypto.SHA256, digest[:]); err != nil {
+			p.t.Fatalf("failed to sign token: %v", err)
+		}
+	case "ES256":
+		r, s, err := ecdsa.Sign(rand.Reader, p.ecKey, digest[:])
+		if err != nil {
+			p.t.Fatalf("failed to sign token: %v", err)
+		}
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestUserTokenRolesAndMemberships(t *testing.T) {
+	env := newTestEnv(t)
+	idp := env.useIdentityProvider()
+
+	web := env.createPriorityRequest("web-app", 0, map[string]string{"hello": "Hello"}, "es")
+
+	// Viewer everywhere, translator in one project
+	alice := idp.sign("RS256", idp.claims("alice", map[string]any{
+		"roles":    []string{"viewer"},
+		"projects": map[string]any{"mobile-app": []string{"translator"}},
+	}))
+
+	body := dto.CreateTranslationRequestRequest{SourceData: map[string]string{"bye": "Bye"}, Languages: []string{"fr"}, Project: "mobile-app"}
+	var created dto.CreateTranslationRequestResponse
+	if status := env.doAs(alice, http.MethodPost, "/api/v1/translations", body, &created); status != http.StatusCreated {
+		t.Fatalf("expected translator to create request of project, got status %d", status)
+	}
+	if request := env.getRequest(created.RequestID); request.CreatedBy != "user:alice" {
+		t.Errorf("expected request to be created by user, got %q", request.CreatedBy)
+	}
+	body.Project = "web-app"
+	if status := env.doAs(alice, http.MethodPost, "/api/v1/translations", body, nil); status != http.StatusForbidden {
+		t.Errorf("expected viewer not to create request, got status %d", status)
+	}
+	if status := env.doAs(alice, http.MethodGet, "/api/v1/translations/"+web, nil, nil); status != http.StatusOK {
+		t.Errorf("expected viewer to read request, got status %d", status)
+	}
+	if status := env.doAs(alice, http.MethodPost, "/api/v1/translations/"+web+"/cancel", nil, nil); status != http.StatusForbidden {
+		t.Errorf("expected viewer not to cancel request, got status %d", status)
+	}
+	if status := env.doAs(alice, http.MethodGet, "/api/v1/admin/api-keys", nil, nil); status != http.StatusForbidden {
+		t.Errorf("expected user without admin role not to manage keys, got status %d", status)
+	}
+
+	// Roles in a project don't grant permissions changing data shared by all projects
+	bob := idp.sign("ES256", idp.claims("bob", map[string]any{
+		"projects": []string{"mobile-app:developer", "mobile-app:admin"},
+	}))
+	cache := dto.CacheTranslationsRequest{Translations: map[string]map[string]string{"en": {"hello": "Hello"}, "es": {"hello": "Hola"}}}
+	if status := env.doAs(bob, http.MethodPost, "/api/v1/translations/cache", cache, nil); status != http.StatusForbidden {
+		t.Errorf("expected project developer not to change cache, got status %d", status)
+	}
+	var list dto.ListTranslationRequestsResponse
+	if status := env.doAs(bob, http.MethodGet, "/api/v1/translations", nil, &list); status != http.StatusOK {
+		t.Fatalf("expected project member to list requests, got status %d", status)
+	}
+	if got := requestIDs(list); len(got) != 1 || got[0] != created.RequestID {
+		t.Errorf("expected only requests of member project, got %v", got)
+	}
+	query := url.Values{"project": {"web-app"}}
+	if status := env.doAs(bob, http.MethodGet, "/api/v1/translations?"+query.Encode(), nil, nil); status != http.StatusForbidden {
+		t.Errorf("expected member not to list another project, got status %d", status)
+	}
+	if status := env.doAs(bob, http.MethodGet, "/api/v1/translations/"+web, nil, nil); status != http.StatusNotFound {
+		t.Errorf("expected request of another project to be hidden, got status %d", status)
+	}
+
+	// Global developers may change cache
+	carol := idp.sign("RS256", idp.claims("carol", map[string]any{"roles": "developer"}))
+	if status := env.doAs(carol, http.MethodPost, "/api/v1/translations/cache", cache, nil); status != http.StatusOK {
+		t.Errorf("expected developer to change cache, got status %d", status)
+	}
+
+	// API keys keep working alongside tokens
+	if status := env.do(http.MethodGet, "/api/v1/translations", nil, nil); status != http.StatusOK {
+		t.Errorf("expected API key to be accepted, got status %d", status)
+	}
+}
+
+func TestUserTokenValidation(t *testing.T) {
+	env := newTestEnv(t)
+	idp := env.useIdentityProvider()
+	roles := map[string]any{"roles": []string{"viewer"}}
+
+	valid := idp.sign("RS256", idp.claims("alice", roles))
+	if status := env.doAs(valid, http.MethodGet, "/api/v1/translations", nil, nil); status != http.StatusOK {
+		t.Fatalf("expected valid token to be accepted, got status %d", status)
+	}
+
+	expired := idp.claims("alice", roles)
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	notYet := idp.claims("alice", roles)
+	notYet["nbf"] = time.Now().Add(time.Hour).Unix()
+	otherIssuer := idp.claims("alice", roles)
+	otherIssuer["iss"] = "https://evil.example.com"
+	otherAudience := idp.claims("alice", roles)
+	otherAudience["aud"] = "another-service"
+	noSubject := idp.claims("", roles)
+
+	other := &identityProvider{t: t}
+	other.rsaKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	payload, _ := json.Marshal(idp.claims("alice", map[string]any{"roles": []string{"admin"}}))
+	unsigned := header + "." + base64.RawURLEncoding.EncodeToString(payload) + "."
+
+	tampered := []byte(valid)
+	tampered[len(valid)/2] ^= 1
+
+	for name, token := range map[string]string{
+		"expired":        idp.sign("RS256", expired),
+		"not yet valid":  idp.sign("ES256", notYet),
+		"other issuer":   idp.sign("RS256", otherIssuer),
+		"other audience": idp.sign("RS256", otherAudience),
+		"no subject":     idp.sign("RS256", noSubject),
+		"unknown key":    other.sign("RS256", idp.claims("alice", roles)),
+		"unsigned":       unsigned,
+		"tampered":       string(tampered),
+	} {
+		if status := env.doAs(token, http.MethodGet, "/api/v1/translations", nil, nil); status != http.StatusUnauthorized {
+			t.Errorf("expected %s token to be rejected, got status %d", name, status)
+		}
+	}
+
+	// Users without known roles are authenticated but allowed nothing
+	nobody := idp.sign("RS256", idp.claims("nobody", map[string]any{"roles": []string{"owner"}}))
+	if status := env.doAs(nobody, http.MethodGet, "/api/v1/translations", nil, nil); status != http.StatusForbidden {
+		t.Errorf("expected user without roles to be forbidden, got status %d", status)
+	}
+}
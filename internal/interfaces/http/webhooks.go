@@ -0,0 +1,365 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// CreateWebhook registers webhook receiving final status events of project requests
+// @Summary Create webhook
+// @Description Register a webhook notified when requests of the project complete, partially complete, fail or are cancelled. Payloads are signed with HMAC-SHA256 of "<X-Webhook-Timestamp>.<body>" in X-Webhook-Signature; the secret is generated when omitted and only returned here
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.CreateWebhookRequest true "Webhook project, URL, secret and events"
+// @Success 201 {object} dto.WebhookInfo
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/webhooks [post]
+func (h *Handler) CreateWebhook(c *fiber.Ctx) error {
+	var req dto.CreateWebhookRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Invalid request body",
+		})
+	}
+
+	if req.Project == "" {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Project is required",
+		})
+	}
+
+	events := make([]domainTranslation.WebhookEvent, 0, len(req.Events))
+	for _, event := range req.Events {
+		events = append(events, domainTranslation.WebhookEvent(event))
+	}
+
+	webhook, err := h.appService.CreateWebhook(c.Context(), req.Project, req.URL, req.Secret, events)
+	if err != nil {
+		if err.Error() == "invalid webhook URL" {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "Webhook URL must be an absolute HTTP(S) URL",
+			})
+		}
+		if err.Error() == "invalid webhook event" {
+			return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error: "Unknown webhook event",
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to create webhook: %v", err),
+		})
+	}
+
+	auditChange(c, webhook.ID.String(), webhook.Project, nil, webhookSummary(webhook))
+
+	info := toWebhookInfo(webhook)
+	info.Secret = webhook.Secret
+
+	return c.Status(http.StatusCreated).JSON(info)
+}
+
+// GetWebhooks lists webhooks
+// @Summary List webhooks
+// @Description Get webhooks ordered by creation time, optionally only those of a project
+// @Tags webhooks
+// @Produce json
+// @Security ApiKeyAuth
+// @Param project query string false "Only return webhooks of this project"
+// @Success 200 {object} dto.GetWebhooksResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/webhooks [get]
+func (h *Handler) GetWebhooks(c *fiber.Ctx) error {
+	var project *string
+	if value := c.Query("project"); value != "" {
+		project = &value
+	}
+
+	webhooks, err := h.appService.GetWebhooks(c.Context(), project)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to get webhooks: %v", err),
+		})
+	}
+
+	// Principals allowed in some projects only see webhooks of those projects
+	projects := visibleProjects(c, domainTranslation.ActionManageWebhooks)
+	infos := make([]dto.WebhookInfo, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		if projects != nil && !slices.Contains(projects, webhook.Project) {
+			continue
+		}
+		infos = append(infos, toWebhookInfo(webhook))
+	}
+
+	return c.JSON(dto.GetWebhooksResponse{
+		Webhooks: infos,
+		Count:    len(infos),
+	})
+}
+
+// DeleteWebhook deletes webhook
+// @Summary Delete webhook
+// @Description Delete webhook, pending deliveries to it are abandoned and its delivery log is kept
+// @Tags webhooks
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Webhook ID" format(uuid)
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/webhooks/{id} [delete]
+func (h *Handler) DeleteWebhook(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Invalid webhook ID format",
+		})
+	}
+
+	// Audit log keeps what was deleted
+	var project string
+	var before map[string]any
+	if webhook, err := h.appService.GetWebhook(c.Context(), id); err == nil {
+		project, before = webhook.Project, webhookSummary(webhook)
+	}
+
+	if err := h.appService.DeleteWebhook(c.Context(), id); err != nil {
+		if err.Error() == "webhook not found" {
+			return c.Status(http.StatusNotFound).JSON(dto.ErrorResponse{
+				Error: "Webhook not found",
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to delete webhook: %v", err),
+		})
+	}
+
+	auditChange(c, id.String(), project, before, nil)
+
+	return c.JSON(fiber.Map{
+		"message": "Webhook deleted successfully",
+	})
+}
+
+// GetDeliveries lists webhook deliveries of a request or webhook
+// @Summary List webhook deliveries
+// @Description Get delivery log with every attempt of a request or a webhook, ordered by creation time
+// @Tags webhooks
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request_id query string false "Only return deliveries of this request" format(uuid)
+// @Param webhook_id query string false "Only return deliveries to this webhook" format(uuid)
+// @Success 200 {object} dto.GetDeliveriesResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/webhooks/deliveries [get]
+func (h *Handler) GetDeliveries(c *fiber.Ctx) error {
+	requestID, err := parseOptionalUUID(c.Query("request_id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Invalid request ID format",
+		})
+	}
+
+	webhookID, err := parseOptionalUUID(c.Query("webhook_id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Invalid webhook ID format",
+		})
+	}
+
+	if requestID == nil && webhookID == nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Either request_id or webhook_id is required",
+		})
+	}
+
+	deliveries, err := h.appService.GetDeliveries(c.Context(), requestID, webhookID)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to get deliveries: %v", err),
+		})
+	}
+
+	infos := make([]dto.DeliveryInfo, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		infos = append(infos, toDeliveryInfo(delivery))
+	}
+
+	return c.JSON(dto.GetDeliveriesResponse{
+		Deliveries: infos,
+		Count:      len(infos),
+	})
+}
+
+// GetDelivery gets webhook delivery by ID
+// @Summary Get webhook delivery
+// @Description Get webhook delivery with payload and every attempt
+// @Tags webhooks
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Delivery ID" format(uuid)
+// @Success 200 {object} dto.DeliveryInfo
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Router /api/v1/webhooks/deliveries/{id} [get]
+func (h *Handler) GetDelivery(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Invalid delivery ID format",
+		})
+	}
+
+	delivery, err := h.appService.GetDelivery(c.Context(), id)
+	if err != nil {
+		return c.Status(http.StatusNotFound).JSON(dto.ErrorResponse{
+			Error: "Delivery not found",
+		})
+	}
+
+	return c.JSON(toDeliveryInfo(delivery))
+}
+
+// RedeliverWebhook sends delivery payload again
+// @Summary Redeliver webhook
+// @Description Send delivery payload again right away with a fresh signature and record the attempt, whatever the delivery status
+// @Tags webhooks
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Delivery ID" format(uuid)
+// @Success 200 {object} dto.DeliveryInfo
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/webhooks/deliveries/{id}/redeliver [post]
+func (h *Handler) RedeliverWebhook(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error: "Invalid delivery ID format",
+		})
+	}
+
+	delivery, err := h.appService.RedeliverWebhook(c.Context(), id)
+	if err != nil {
+		if err.Error() == "delivery not found" {
+			return c.Status(http.StatusNotFound).JSON(dto.ErrorResponse{
+				Error: "Delivery not found",
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error: fmt.Sprintf("Failed to redeliver webhook: %v", err),
+		})
+	}
+
+	auditChange(c, delivery.ID.String(), "", nil, map[string]any{
+		"status":     string(delivery.Status),
+		"request_id": delivery.RequestID.String(),
+		"url":        delivery.URL,
+	})
+
+	return c.JSON(toDeliveryInfo(delivery))
+}
+
+// webhookSummary summarizes webhook for audit log, without its secret
+func webhookSummary(webhook *domainTranslation.Webhook) map[string]any {
+	return map[string]any{"url": webhook.URL, "events": webhook.Events}
+}
+
+// parseOptionalUUID parses UUID query value, empty value yields nil
+func parseOptionalUUID(value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// toWebhookInfo converts domain webhook to DTO without its secret
+func toWebhookInfo(webhook *domainTranslation.Webhook) dto.WebhookInfo {
+	events := make([]string, 0, len(webhook.Events))
+	for _, event := range webhook.Events {
+		events = append(events, string(event))
+	}
+
+	return dto.WebhookInfo{
+		ID:        webhook.ID.String(),
+		Project:   webhook.Project,
+		URL:       webhook.URL,
+		Events:    events,
+		CreatedAt: webhook.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// toDeliveryInfo converts domain webhook delivery to DTO
+func toDeliveryInfo(delivery *domainTranslation.WebhookDelivery) dto.DeliveryInfo {
+	info := dto.DeliveryInfo{
+		ID:        delivery.ID.String(),
+		RequestID: delivery.RequestID.String(),
+		URL:       delivery.URL,
+		Event:     string(delivery.Event),
+		Payload:   delivery.Payload,
+		Status:    string(delivery.Status),
+		Attempts:  make([]dto.DeliveryAttemptInfo, 0, len(delivery.Attempts)),
+		CreatedAt: delivery.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt: delivery.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if delivery.WebhookID != nil {
+		webhookID := delivery.WebhookID.String()
+		info.WebhookID = &webhookID
+	}
+
+	if delivery.NextAttemptAt != nil {
+		nextAttemptAt := delivery.NextAttemptAt.Format("2006-01-02T15:04:05Z")
+		info.NextAttemptAt = &nextAttemptAt
+	}
+
+	for _, attempt := range delivery.Attempts {
+		info.Attempts = append(info.Attempts, dto.DeliveryAttemptInfo{
+			StatusCode: attempt.StatusCode,
+			Error:      attempt.Error,
+			DurationMs: attempt.Duration.Milliseconds(),
+			CreatedAt:  attempt.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		})
+	}
+
+	return info
+}
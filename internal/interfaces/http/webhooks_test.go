@@ -0,0 +1,366 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	appTranslation "translation/internal/application/translation"
+	domainTranslation "translation/internal/domain/translation"
+	"translation/internal/interfaces/http/dto"
+)
+
+// receivedWebhook represents webhook request received by test receiver
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+// webhookReceiver records webhook requests and answers them with scripted status codes
+type webhookReceiver struct {
+	server   *httptest.Server
+	mu       sync.Mutex
+	statuses []int
+	received []receivedWebhook
+}
+
+// newWebhookReceiver starts receiver answering with statuses in order, then with 200
+func newWebhookReceiver(t *testing.T, statuses ...int) *webhookReceiver {
+	t.Helper()
+
+	r := &webhookReceiver{statuses: statuses}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+
+		r.mu.Lock()
+		r.received = append(r.received, receivedWebhook{header: req.Header.Clone(), body: body})
+		status := http.StatusOK
+		if len(r.statuses) > 0 {
+			status, r.statuses = r.statuses[0], r.statuses[1:]
+		}
+		r.mu.Unlock()
+
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(r.server.Close)
+
+	return r
+}
+
+// requests returns copy of received requests
+func (r *webhookReceiver) requests() []receivedWebhook {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]receivedWebhook(nil), r.received...)
+}
+
+// startWebhookDispatcher starts delivering webhooks until the test ends
+func (e *testEnv) startWebhookDispatcher() {
+	e.t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	e.t.Cleanup(cancel)
+
+	if err := e.appService.StartWebhookDispatcher(ctx); err != nil {
+		e.t.Fatalf("failed to start webhook dispatcher: %v", err)
+	}
+}
+
+// createWebhook registers webhook and returns it with its secret
+func (e *testEnv) createWebhook(project, url string, events ...string) dto.WebhookInfo {
+	e.t.Helper()
+
+	var resp dto.WebhookInfo
+	status := e.do(http.MethodPost, "/api/v1/webhooks", dto.CreateWebhookRequest{
+		Project: project,
+		URL:     url,
+		Events:  events,
+	}, &resp)
+	if status != http.StatusCreated {
+		e.t.Fatalf("expected status 201, got %d", status)
+	}
+
+	return resp
+}
+
+// createProjectRequest creates translation request of project with optional callback URL
+func (e *testEnv) createProjectRequest(project, callbackURL string, sourceData map[string]string, languages ...string) string {
+	e.t.Helper()
+
+	var resp dto.CreateTranslationRequestResponse
+	status := e.do(http.MethodPost, "/api/v1/translations", dto.CreateTranslationRequestRequest{
+		SourceData:  sourceData,
+		Languages:   languages,
+		Project:     project,
+		CallbackURL: callbackURL,
+	}, &resp)
+	if status != http.StatusCreated {
+		e.t.Fatalf("expected status 201, got %d", status)
+	}
+
+	return resp.RequestID
+}
+
+// waitForDeliveries polls delivery log of request until all of its count deliveries are no longer pending
+func (e *testEnv) waitForDeliveries(requestID string, count int) []dto.DeliveryInfo {
+	e.t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		var resp dto.GetDeliveriesResponse
+		if status := e.do(http.MethodGet, "/api/v1/webhooks/deliveries?request_id="+requestID, nil, &resp); status != http.StatusOK {
+			e.t.Fatalf("expected status 200, got %d", status)
+		}
+
+		settled := len(resp.Deliveries) == count
+		for _, delivery := range resp.Deliveries {
+			if delivery.Status == string(domainTranslation.DeliveryStatusPending) {
+				settled = false
+			}
+		}
+		if settled {
+			return resp.Deliveries
+		}
+		if time.Now().After(deadline) {
+			e.t.Fatalf("deliveries of request %s did not settle: %+v", requestID, resp.Deliveries)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// checkSignature verifies webhook signature with secret and decodes its payload
+func checkSignature(t *testing.T, received receivedWebhook, secret string) appTranslation.WebhookPayload {
+	t.Helper()
+
+	timestamp, err := strconv.ParseInt(received.header.Get("X-Webhook-Timestamp"), 10, 64)
+	if err != nil {
+		t.Fatalf("invalid timestamp header: %v", err)
+	}
+	want := "sha256=" + domainTranslation.SignPayload(secret, timestamp, received.body)
+	if got := received.header.Get("X-Webhook-Signature"); got != want {
+		t.Errorf("signature = %q, want %q", got, want)
+	}
+
+	var payload appTranslation.WebhookPayload
+	if err := json.Unmarshal(received.body, &payload); err != nil {
+		t.Fatalf("failed to decode payload %q: %v", received.body, err)
+	}
+	if got := received.header.Get("X-Webhook-Event"); got != string(payload.Event) {
+		t.Errorf("event header %q does not match payload event %q", got, payload.Event)
+	}
+
+	return payload
+}
+
+func TestWebhooksAreSignedAndDelivered(t *testing.T) {
+	env := newTestEnv(t)
+	env.startConsumer()
+	env.startWebhookDispatcher()
+
+	projectReceiver := newWebhookReceiver(t)
+	callbackReceiver := newWebhookReceiver(t)
+	otherReceiver := newWebhookReceiver(t)
+
+	webhook := env.createWebhook("mobile", projectReceiver.server.URL)
+	if webhook.Secret == "" {
+		t.Fatal("expected generated secret on creation")
+	}
+	env.createWebhook("web", otherReceiver.server.URL)
+
+	id := env.createProjectRequest("mobile", callbackReceiver.server.URL, map[string]string{"hello": "Hello"}, "es")
+	env.waitForStatus(id, domainTranslation.StatusCompleted)
+
+	deliveries := env.waitForDeliveries(id, 2)
+	for _, delivery := range deliveries {
+		if delivery.Status != string(domainTranslation.DeliveryStatusSucceeded) || len(delivery.Attempts) != 1 {
+			t.Errorf("expected delivery to succeed at first attempt, got %+v", delivery)
+		}
+	}
+
+	received := projectReceiver.requests()
+	if len(received) != 1 {
+		t.Fatalf("expected one project webhook request, got %d", len(received))
+	}
+	payload := checkSignature(t, received[0], webhook.Secret)
+	if payload.Event != domainTranslation.WebhookEventCompleted || payload.RequestID.String() != id ||
+		payload.Project != "mobile" || payload.Total != 1 || payload.Done != 1 {
+		t.Errorf("unexpected payload: %+v", payload)
+	}
+
+	received = callbackReceiver.requests()
+	if len(received) != 1 {
+		t.Fatalf("expected one callback request, got %d", len(received))
+	}
+	checkSignature(t, received[0], env.webhooks.Secret)
+
+	if got := len(otherReceiver.requests()); got != 0 {
+		t.Errorf("expected webhook of other project not to be called, got %d requests", got)
+	}
+
+	// Secret is only revealed on creation
+	var list dto.GetWebhooksResponse
+	env.do(http.MethodGet, "/api/v1/webhooks?project=mobile", nil, &list)
+	if list.Count != 1 || list.Webhooks[0].ID != webhook.ID || list.Webhooks[0].Secret != "" {
+		t.Errorf("unexpected webhook list: %+v", list)
+	}
+}
+
+func TestWebhookRetriesAndRedelivery(t *testing.T) {
+	env := newTestEnv(t)
+	env.startConsumer()
+	env.startWebhookDispatcher()
+
+	flaky := newWebhookReceiver(t, http.StatusInternalServerError, http.StatusServiceUnavailable)
+	broken := newWebhookReceiver(t, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError)
+
+	flakyWebhook := env.createWebhook("mobile", flaky.server.URL)
+	brokenWebhook := env.createWebhook("mobile", broken.server.URL)
+
+	id := env.createProjectRequest("mobile", "", map[string]string{"hello": "Hello"}, "es")
+	env.waitForStatus(id, domainTranslation.StatusCompleted)
+
+	byWebhook := map[string]dto.DeliveryInfo{}
+	for _, delivery := range env.waitForDeliveries(id, 2) {
+		byWebhook[*delivery.WebhookID] = delivery
+	}
+
+	succeeded := byWebhook[flakyWebhook.ID]
+	if succeeded.Status != string(domainTranslation.DeliveryStatusSucceeded) || len(succeeded.Attempts) != 3 {
+		t.Fatalf("expected flaky webhook to succeed at third attempt, got %+v", succeeded)
+	}
+	if succeeded.Attempts[0].StatusCode != http.StatusInternalServerError || succeeded.Attempts[0].Error == "" {
+		t.Errorf("expected failed attempt to be logged, got %+v", succeeded.Attempts[0])
+	}
+
+	failed := byWebhook[brokenWebhook.ID]
+	if failed.Status != string(domainTranslation.DeliveryStatusFailed) || len(failed.Attempts) != 3 || failed.NextAttemptAt != nil {
+		t.Fatalf("expected broken webhook to fail after max attempts, got %+v", failed)
+	}
+
+	// Receiver is fixed now, manual redelivery succeeds and keeps the attempt log
+	var redelivered dto.DeliveryInfo
+	if status := env.do(http.MethodPost, "/api/v1/webhooks/deliveries/"+failed.ID+"/redeliver", nil, &redelivered); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if redelivered.Status != string(domainTranslation.DeliveryStatusSucceeded) || len(redelivered.Attempts) != 4 {
+		t.Errorf("expected redelivery to succeed as fourth attempt, got %+v", redelivered)
+	}
+
+	received := broken.requests()
+	if len(received) != 4 || received[3].header.Get("X-Webhook-ID") != failed.ID {
+		t.Errorf("expected redelivery to reach receiver with delivery ID, got %d requests", len(received))
+	}
+
+	var log dto.GetDeliveriesResponse
+	env.do(http.MethodGet, "/api/v1/webhooks/deliveries?webhook_id="+brokenWebhook.ID, nil, &log)
+	if log.Count != 1 || log.Deliveries[0].ID != failed.ID {
+		t.Errorf("unexpected delivery log of webhook: %+v", log)
+	}
+
+	var single dto.DeliveryInfo
+	if status := env.do(http.MethodGet, "/api/v1/webhooks/deliveries/"+failed.ID, nil, &single); status != http.StatusOK || single.Status != redelivered.Status {
+		t.Errorf("expected stored delivery to match redelivery, got %d %+v", status, single)
+	}
+}
+
+func TestWebhookEventFilterAndCancellation(t *testing.T) {
+	env := newTestEnv(t)
+	env.startWebhookDispatcher()
+
+	receiver := newWebhookReceiver(t)
+	env.createWebhook("mobile", receiver.server.URL, string(domainTranslation.WebhookEventCancelled))
+
+	id := env.createProjectRequest("mobile", "", map[string]string{"hello": "Hello"}, "es")
+	if status := env.do(http.MethodPost, "/api/v1/translations/"+id+"/cancel", nil, nil); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	deliveries := env.waitForDeliveries(id, 1)
+	if deliveries[0].Event != string(domainTranslation.WebhookEventCancelled) {
+		t.Errorf("expected cancelled event, got %s", deliveries[0].Event)
+	}
+
+	// Completion is not subscribed to
+	env.startConsumer()
+	completed := env.createProjectRequest("mobile", "", map[string]string{"bye": "Bye"}, "es")
+	env.waitForStatus(completed, domainTranslation.StatusCompleted)
+	env.waitForDeliveries(completed, 0)
+
+	if got := len(receiver.requests()); got != 1 {
+		t.Errorf("expected only cancelled event to be delivered, got %d requests", got)
+	}
+}
+
+func TestWebhookInternalReceiversAreRefused(t *testing.T) {
+	env := newTestEnv(t)
+	env.webhooks.AllowedNetworks = nil
+	env.restart()
+	env.startWebhookDispatcher()
+
+	// Names resolving to internal addresses are refused like the addresses themselves
+	receiver := newWebhookReceiver(t)
+	env.createWebhook("mobile", receiver.server.URL)
+	env.createWebhook("mobile", strings.Replace(receiver.server.URL, "127.0.0.1", "localhost", 1))
+
+	id := env.createProjectRequest("mobile", "", map[string]string{"hello": "Hello"}, "es")
+	env.cancelRequest(id, dto.CancelTranslationRequestRequest{})
+
+	for _, delivery := range env.waitForDeliveries(id, 2) {
+		if delivery.Status != string(domainTranslation.DeliveryStatusFailed) || len(delivery.Attempts) != 3 {
+			t.Fatalf("expected delivery to internal receiver to fail, got %+v", delivery)
+		}
+		if attempt := delivery.Attempts[0]; !strings.Contains(attempt.Error, "is not allowed") || attempt.StatusCode != 0 {
+			t.Errorf("expected connection to be refused, got %+v", attempt)
+		}
+	}
+	if got := len(receiver.requests()); got != 0 {
+		t.Errorf("expected internal receiver not to be reached, got %d requests", got)
+	}
+}
+
+func TestWebhookValidation(t *testing.T) {
+	env := newTestEnv(t)
+
+	cases := []struct {
+		name string
+		body dto.CreateWebhookRequest
+	}{
+		{"missing project", dto.CreateWebhookRequest{URL: "https://example.com/hook"}},
+		{"relative URL", dto.CreateWebhookRequest{Project: "mobile", URL: "/hook"}},
+		{"unsupported scheme", dto.CreateWebhookRequest{Project: "mobile", URL: "ftp://example.com/hook"}},
+		{"unknown event", dto.CreateWebhookRequest{Project: "mobile", URL: "https://example.com/hook", Events: []string{"request.started"}}},
+	}
+	for _, tc := range cases {
+		if status := env.do(http.MethodPost, "/api/v1/webhooks", tc.body, nil); status != http.StatusBadRequest {
+			t.Errorf("%s: expected status 400, got %d", tc.name, status)
+		}
+	}
+
+	status := env.do(http.MethodPost, "/api/v1/translations", dto.CreateTranslationRequestRequest{
+		SourceData:  map[string]string{"hello": "Hello"},
+		Languages:   []string{"es"},
+		CallbackURL: "not a url",
+	}, nil)
+	if status != http.StatusBadRequest {
+		t.Errorf("expected status 400 for invalid callback URL, got %d", status)
+	}
+
+	if status := env.do(http.MethodGet, "/api/v1/webhooks/deliveries", nil, nil); status != http.StatusBadRequest {
+		t.Errorf("expected status 400 without delivery filter, got %d", status)
+	}
+
+	webhook := env.createWebhook("mobile", "https://example.com/hook")
+	if status := env.do(http.MethodDelete, "/api/v1/webhooks/"+webhook.ID, nil, nil); status != http.StatusOK {
+		t.Errorf("expected status 200 on delete, got %d", status)
+	}
+	if status := env.do(http.MethodDelete, "/api/v1/webhooks/"+webhook.ID, nil, nil); status != http.StatusNotFound {
+		t.Errorf("expected status 404 on repeated delete, got %d", status)
+	}
+}
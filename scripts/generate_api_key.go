@@ -1,12 +1,86 @@
 package main
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"flag"
 	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"translation/internal/config"
+	domainTranslation "translation/internal/domain/translation"
+	redisRepo "translation/internal/infrastructure/redis"
+
+	"github.com/redis/go-redis/v9"
 )
 
+const usage = `Usage: go run scripts/generate_api_key.go <command> [flags]
+
+Commands:
+  generate                 Generate a bootstrap key for API_KEY (default)
+  create                   Create a managed API key
+      -name NAME               Name of the key (required)
+      -permissions LIST        Comma-separated translate, read, cache:write, admin (required)
+      -projects LIST           Comma-separated projects the key is limited to
+      -expires-in DURATION     Lifetime of the key, e.g. 720h
+  list                     List managed API keys
+  rotate [-overlap 24h] ID Replace key with a new one, the old key stays valid for overlap
+  revoke ID                Revoke key
+
+Managed keys are stored in Redis configured by REDIS_URL, REDIS_PASSWORD and REDIS_DB.
+`
+
 func main() {
+	command := "generate"
+	args := os.Args[1:]
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
+	}
+
+	if command == "generate" {
+		generate()
+		return
+	}
+
+	if command == "help" || command == "-h" || command == "--help" {
+		fmt.Print(usage)
+		return
+	}
+
+	service, closeRedis, err := connect()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	defer closeRedis()
+
+	ctx := context.Background()
+	switch command {
+	case "create":
+		err = create(ctx, service, args)
+	case "list":
+		err = list(ctx, service)
+	case "rotate":
+		err = rotate(ctx, service, args)
+	case "revoke":
+		err = revoke(ctx, service, args)
+	default:
+		fmt.Print(usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// generate prints random key to use as bootstrap API_KEY
+func generate() {
 	// Generate 32 bytes (256 bits) of random data
 	bytes := make([]byte, 32)
 	if _, err := rand.Read(bytes); err != nil {
@@ -20,3 +94,148 @@ func main() {
 	fmt.Printf("Generated API Key: %s\n", apiKey)
 	fmt.Printf("Add this to your .env file as: API_KEY=%s\n", apiKey)
 }
+
+// connect creates domain service storing keys in Redis configured in environment
+func connect() (*domainTranslation.Service, func(), error) {
+	cfg, err := config.Load(config.ModeAPI)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     cfg.Redis.URL,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		client.Close()
+		return nil, nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	service := domainTranslation.NewService(redisRepo.NewRepository(client))
+	return service, func() { client.Close() }, nil
+}
+
+// create creates managed API key and prints it, it can't be shown again
+func create(ctx context.Context, service *domainTranslation.Service, args []string) error {
+	flags := flag.NewFlagSet("create", flag.ExitOnError)
+	name := flags.String("name", "", "name of the key")
+	permissions := flags.String("permissions", "", "comma-separated permissions")
+	projects := flags.String("projects", "", "comma-separated projects the key is limited to")
+	expiresIn := flags.Duration("expires-in", 0, "lifetime of the key")
+	flags.Parse(args)
+
+	options := domainTranslation.CreateAPIKeyOptions{
+		Name:     *name,
+		Projects: splitList(*projects),
+	}
+	for _, permission := range splitList(*permissions) {
+		options.Permissions = append(options.Permissions, domainTranslation.Permission(permission))
+	}
+	if *expiresIn > 0 {
+		expiresAt := time.Now().Add(*expiresIn)
+		options.ExpiresAt = &expiresAt
+	}
+
+	// Whoever can reach Redis may create any key
+	key, secret, err := service.CreateAPIKey(ctx, nil, options)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created API key %s (%s)\n", key.ID, key.Name)
+	fmt.Printf("Key: %s\n", secret)
+	fmt.Println("Store it now, it can't be shown again")
+	return nil
+}
+
+// list prints managed API keys
+func list(ctx context.Context, service *domainTranslation.Service) error {
+	keys, err := service.GetAPIKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tPREFIX\tPERMISSIONS\tPROJECTS\tSTATUS\tEXPIRES\tLAST USED")
+	now := time.Now()
+	for _, key := range keys {
+		permissions := make([]string, 0, len(key.Permissions))
+		for _, permission := range key.Permissions {
+			permissions = append(permissions, string(permission))
+		}
+
+		projects := "*"
+		if len(key.Projects) > 0 {
+			projects = strings.Join(key.Projects, ",")
+		}
+
+		status := "active"
+		switch {
+		case key.RevokedAt != nil:
+			status = "revoked"
+		case !key.Active(now):
+			status = "expired"
+		case key.ReplacedBy != "":
+			status = "rotated"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", key.ID, key.Name, key.Prefix,
+			strings.Join(permissions, ","), projects, status, formatTime(key.ExpiresAt), formatTime(key.LastUsedAt))
+	}
+	return w.Flush()
+}
+
+// rotate replaces managed API key with a new one and prints it
+func rotate(ctx context.Context, service *domainTranslation.Service, args []string) error {
+	flags := flag.NewFlagSet("rotate", flag.ExitOnError)
+	overlap := flags.Duration("overlap", domainTranslation.DefaultAPIKeyRotationOverlap, "how long the old key stays valid")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		return fmt.Errorf("API key ID is required")
+	}
+
+	key, secret, err := service.RotateAPIKey(ctx, nil, flags.Arg(0), *overlap)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Rotated API key %s to %s, the old key expires in %s\n", flags.Arg(0), key.ID, *overlap)
+	fmt.Printf("Key: %s\n", secret)
+	fmt.Println("Store it now, it can't be shown again")
+	return nil
+}
+
+// revoke revokes managed API key
+func revoke(ctx context.Context, service *domainTranslation.Service, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("API key ID is required")
+	}
+
+	key, err := service.RevokeAPIKey(ctx, nil, args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Revoked API key %s (%s)\n", key.ID, key.Name)
+	return nil
+}
+
+// splitList splits comma-separated flag value, skipping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// formatTime formats optional time for listing
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format("2006-01-02T15:04:05Z")
+}